@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// accessKind describes the repositories or organizations that a runner group
+// with selected visibility is shared with, and holds the flags of its commands
+type accessKind struct {
+	scopeKind runnergroup.ScopeKind
+	noun      string // e.g. "repository"
+	plural    string // e.g. "repositories"
+	fields    []string
+
+	// resolve returns the IDs of the entries given by the user
+	resolve func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, refs []string) ([]int, error)
+	// list fetches the entries of a runner group
+	list   func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID int) (*accessList, error)
+	add    func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID, id int) error
+	remove func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID, id int) error
+	set    func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID int, ids []int) error
+	// qualify completes a name given by the user, e.g. with the organization; optional
+	qualify func(scope runnergroup.Scope, ref string) string
+
+	listFormat string
+	listExport exportOptions
+	yes        bool
+}
+
+// accessEntry is a repository or organization as shown to the user
+type accessEntry struct {
+	ID   int
+	Name string
+}
+
+// accessList holds the entries of a runner group and their output forms
+type accessList struct {
+	entries []accessEntry
+	table   *runnergroup.Table
+	// items are the API values exported with --json
+	items interface{}
+}
+
+// register adds the subcommands to parent along with their flags
+func (k *accessKind) register(parent, list, add, remove, set *cobra.Command) {
+	for _, cmd := range []*cobra.Command{list, add, remove, set} {
+		// Add the scope and --hostname flags
+		addScopeFlags(cmd, k.scopeKind)
+		parent.AddCommand(cmd)
+	}
+
+	// Add the --json, --jq, --template and --format flags
+	addJSONFlags(list, &k.listExport, k.fields)
+	addFormatFlag(list, &k.listFormat)
+
+	// Add the --yes flag to the subcommands that can remove access
+	remove.Flags().BoolVarP(&k.yes, "yes", "y", false, "Remove access without asking for confirmation")
+	set.Flags().BoolVarP(&k.yes, "yes", "y", false, "Remove access without asking for confirmation")
+}
+
+func (k *accessKind) runList(cmd *cobra.Command, args []string) {
+	// Validate JSON output flags if provided
+	if err := k.listExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate output format
+	if _, err := runnergroup.LookupFormatter(k.listFormat); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, client, ctx, cancel := accessSetup(cmd)
+	defer cancel()
+
+	group := resolveSelectedGroup(ctx, client, scope, args[0])
+
+	list, err := k.list(client, ctx, scope, group.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Output selected fields as JSON if requested
+	if k.listExport.enabled() {
+		if err := k.listExport.writeFields(os.Stdout, list.items); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := writeTable(os.Stdout, k.listFormat, list.table); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (k *accessKind) runAdd(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := accessSetup(cmd)
+	defer cancel()
+
+	group := resolveSelectedGroup(ctx, client, scope, args[0])
+	refs := args[1:]
+
+	ids, err := k.resolve(client, ctx, scope, refs)
+	if err != nil {
+		log.Fatal(errorMessage(err, ""))
+	}
+
+	for i, id := range ids {
+		if err := k.add(client, ctx, scope, group.ID, id); err != nil {
+			log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+		}
+		fmt.Printf("Added %s to runner group %s (ID %d)\n", k.entryName(scope, refs[i]), group.Name, group.ID)
+	}
+}
+
+func (k *accessKind) runRemove(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := accessSetup(cmd)
+	defer cancel()
+
+	group := resolveSelectedGroup(ctx, client, scope, args[0])
+	refs := args[1:]
+
+	ids, err := k.resolve(client, ctx, scope, refs)
+	if err != nil {
+		log.Fatal(errorMessage(err, ""))
+	}
+
+	ok, err := confirm(fmt.Sprintf("Remove %s from runner group %s?", k.count(len(ids)), group.Name), k.yes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !ok {
+		log.Fatal("cancelled")
+	}
+
+	for i, id := range ids {
+		if err := k.remove(client, ctx, scope, group.ID, id); err != nil {
+			log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+		}
+		fmt.Printf("Removed %s from runner group %s (ID %d)\n", k.entryName(scope, refs[i]), group.Name, group.ID)
+	}
+}
+
+func (k *accessKind) runSet(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := accessSetup(cmd)
+	defer cancel()
+
+	group := resolveSelectedGroup(ctx, client, scope, args[0])
+	refs := args[1:]
+
+	ids, err := k.resolve(client, ctx, scope, refs)
+	if err != nil {
+		log.Fatal(errorMessage(err, ""))
+	}
+
+	current, err := k.list(client, ctx, scope, group.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Show what will change before asking for confirmation
+	added, removed := k.diff(current.entries, scope, refs, ids)
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Fprintf(os.Stderr, "Runner group %s (ID %d) already has these %s\n", group.Name, group.ID, k.plural)
+		return
+	}
+	printAccessChanges(os.Stderr, fmt.Sprintf("Changes to the %s of runner group %s (ID %d):", k.plural, group.Name, group.ID), added, removed)
+
+	if len(removed) > 0 {
+		question := fmt.Sprintf("Remove %s from the group?", k.count(len(removed)))
+		if len(ids) == 0 {
+			question = fmt.Sprintf("Remove all %s from the group? No %s will be able to use its runners.", k.count(len(removed)), k.noun)
+		}
+		ok, err := confirm(question, k.yes)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			log.Fatal("cancelled")
+		}
+	}
+
+	if err := k.set(client, ctx, scope, group.ID, ids); err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	fmt.Printf("Updated the %s of runner group %s (ID %d): %d added, %d removed\n", k.plural, group.Name, group.ID, len(added), len(removed))
+}
+
+// entryName returns an entry given by the user as shown in messages
+func (k *accessKind) entryName(scope runnergroup.Scope, ref string) string {
+	if _, err := strconv.Atoi(ref); err == nil {
+		return "ID " + ref
+	}
+	if k.qualify != nil {
+		return k.qualify(scope, ref)
+	}
+	return ref
+}
+
+// diff returns the names of the given entries that are not selected yet and
+// of the selected entries that are not given
+func (k *accessKind) diff(current []accessEntry, scope runnergroup.Scope, refs []string, ids []int) (added, removed []string) {
+	currentIDs := make(map[int]bool, len(current))
+	for _, entry := range current {
+		currentIDs[entry.ID] = true
+	}
+	wantIDs := make(map[int]bool, len(ids))
+	for i, id := range ids {
+		wantIDs[id] = true
+		if !currentIDs[id] {
+			added = append(added, k.entryName(scope, refs[i]))
+		}
+	}
+	for _, entry := range current {
+		if !wantIDs[entry.ID] {
+			removed = append(removed, entry.Name)
+		}
+	}
+	return added, removed
+}
+
+// count returns e.g. "1 repository" or "2 repositories"
+func (k *accessKind) count(n int) string {
+	if n == 1 {
+		return "1 " + k.noun
+	}
+	return fmt.Sprintf("%d %s", n, k.plural)
+}
+
+// accessSetup returns the scope, API client and context shared by the repos and orgs subcommands
+func accessSetup(cmd *cobra.Command) (runnergroup.Scope, *runnergroup.Client, context.Context, context.CancelFunc) {
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	return scope, client, ctx, cancel
+}
+
+// resolveSelectedGroup looks up a runner group by ID or name and exits unless
+// it is limited to selected repositories or organizations
+func resolveSelectedGroup(ctx context.Context, client *runnergroup.Client, scope runnergroup.Scope, ref string) *runnergroup.RunnerGroup {
+	group, err := client.ResolveGroup(ctx, scope, ref)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", ref, scope)))
+	}
+	if err := runnergroup.CheckSelectedVisibility(scope, *group); err != nil {
+		log.Fatal(err)
+	}
+	return group
+}
+
+// printAccessChanges prints the entries that gain access prefixed with "+"
+// and those that lose it prefixed with "-"
+func printAccessChanges(w io.Writer, title string, added, removed []string) {
+	fmt.Fprintln(w, title)
+	for _, name := range added {
+		fmt.Fprintf(w, "  + %s\n", name)
+	}
+	for _, name := range removed {
+		fmt.Fprintf(w, "  - %s\n", name)
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestAccessKind_EntryName(t *testing.T) {
+	tests := []struct {
+		kind     *accessKind
+		scope    runnergroup.Scope
+		ref      string
+		expected string
+	}{
+		{reposAccess, runnergroup.OrganizationScope("myorg"), "app", "myorg/app"},
+		{reposAccess, runnergroup.OrganizationScope("myorg"), "other/library", "other/library"},
+		{reposAccess, runnergroup.OrganizationScope("myorg"), "13", "ID 13"},
+		{orgsAccess, runnergroup.EnterpriseScope("myenterprise"), "myorg", "myorg"},
+		{orgsAccess, runnergroup.EnterpriseScope("myenterprise"), "23", "ID 23"},
+	}
+	for _, tt := range tests {
+		if name := tt.kind.entryName(tt.scope, tt.ref); name != tt.expected {
+			t.Errorf("%s entryName(%q): expected %q, got %q", tt.kind.noun, tt.ref, tt.expected, name)
+		}
+	}
+}
+
+func TestAccessKind_Diff(t *testing.T) {
+	current := []accessEntry{
+		{ID: 11, Name: "myorg/app"},
+		{ID: 12, Name: "myorg/old"},
+	}
+
+	added, removed := reposAccess.diff(current, runnergroup.OrganizationScope("myorg"), []string{"app", "new"}, []int{11, 13})
+	if !reflect.DeepEqual(added, []string{"myorg/new"}) {
+		t.Errorf("Expected added [myorg/new], got %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"myorg/old"}) {
+		t.Errorf("Expected removed [myorg/old], got %v", removed)
+	}
+
+	// Without entries every selected one is removed
+	added, removed = reposAccess.diff(current, runnergroup.OrganizationScope("myorg"), nil, nil)
+	if len(added) != 0 || !reflect.DeepEqual(removed, []string{"myorg/app", "myorg/old"}) {
+		t.Errorf("Expected all entries removed, got added %v removed %v", added, removed)
+	}
+}
+
+func TestAccessKind_Count(t *testing.T) {
+	if count := reposAccess.count(1); count != "1 repository" {
+		t.Errorf("Expected %q, got %q", "1 repository", count)
+	}
+	if count := orgsAccess.count(3); count != "3 organizations" {
+		t.Errorf("Expected %q, got %q", "3 organizations", count)
+	}
+}
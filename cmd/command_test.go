@@ -3,6 +3,8 @@ package cmd
 import (
 	"strings"
 	"testing"
+
+	"github.com/spf13/cobra"
 )
 
 // Test help text content for list command
@@ -35,6 +37,7 @@ func TestRunnersCommand_HelpContent(t *testing.T) {
 		"enterprise name (--enterprise flag)",
 		"organization name (--org flag)",
 		"runner group ID as a positional argument",
+		"repository (--repo flag)",
 		"GitHub.com enterprise",
 		"GitHub.com organization",
 		"GitHub Enterprise Server",
@@ -72,6 +75,7 @@ func TestRunnersCommand_UsageExamples(t *testing.T) {
 	expectedExamples := []string{
 		"gh-runner-group runners 123 --enterprise myenterprise",
 		"gh-runner-group runners 123 --org myorg",
+		"gh-runner-group runners --repo myorg/myrepo",
 		"--hostname github.example.com",
 		"GH_HOST=github.example.com",
 	}
@@ -100,7 +104,7 @@ func TestRunnersCommand_Description(t *testing.T) {
 
 // Test command usage strings
 func TestRunnersCommand_Usage(t *testing.T) {
-	expected := "runners <runner-group-id>"
+	expected := "runners <runner-group>"
 	if runnersCmd.Use != expected {
 		t.Errorf("Expected usage %q, got %q", expected, runnersCmd.Use)
 	}
@@ -128,4 +132,21 @@ func TestRootCommand_Description(t *testing.T) {
 			t.Errorf("Expected root help text to contain %q, but it doesn't. Help text: %q", expected, help)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// Test that list and runners share the --format flag
+func TestCommands_FormatFlag(t *testing.T) {
+	for _, cmd := range []*cobra.Command{listCmd, runnersCmd} {
+		flag := cmd.Flags().Lookup("format")
+		if flag == nil {
+			t.Errorf("Expected %s to have a --format flag", cmd.Name())
+			continue
+		}
+		if flag.DefValue != "table" {
+			t.Errorf("Expected %s --format to default to table, got %q", cmd.Name(), flag.DefValue)
+		}
+		if !strings.Contains(flag.Usage, "csv, markdown, table, tsv, yaml") {
+			t.Errorf("Expected %s --format usage to list the formats, got %q", cmd.Name(), flag.Usage)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/prompter"
+	"github.com/cli/go-gh/v2/pkg/term"
+)
+
+// errNonInteractive is returned when a change needs confirmation but no terminal is attached
+var errNonInteractive = errors.New("refusing to make changes without confirmation; use --yes when not running interactively")
+
+// canPrompt reports whether both stdin and stdout are attached to a terminal
+func canPrompt() bool {
+	return term.IsTerminal(os.Stdin) && term.FromEnv().IsTerminalOutput()
+}
+
+// confirm asks the user to confirm a change unless --yes was given
+func confirm(question string, yes bool) (bool, error) {
+	if yes {
+		return true, nil
+	}
+	if !canPrompt() {
+		return false, errNonInteractive
+	}
+	return prompter.New(os.Stdin, os.Stdout, os.Stderr).Confirm(question, false)
+}
+
+// confirmByName asks the user to type name to confirm a destructive change unless --yes was given
+func confirmByName(question, name string, yes bool) error {
+	if yes {
+		return nil
+	}
+	if !canPrompt() {
+		return errNonInteractive
+	}
+
+	answer, err := prompter.New(os.Stdin, os.Stdout, os.Stderr).Input(fmt.Sprintf("%s Type %s to confirm:", question, name), "")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(answer) != name {
+		return fmt.Errorf("confirmation did not match %q; nothing was changed", name)
+	}
+	return nil
+}
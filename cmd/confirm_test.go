@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConfirm_NonInteractive(t *testing.T) {
+	// Tests do not run attached to a terminal, so only --yes may confirm
+	if ok, err := confirm("Apply?", true); !ok || err != nil {
+		t.Errorf("Expected --yes to confirm, got %v, %v", ok, err)
+	}
+	if _, err := confirm("Apply?", false); !errors.Is(err, errNonInteractive) {
+		t.Errorf("Expected errNonInteractive, got %v", err)
+	}
+
+	if err := confirmByName("Delete?", "gpu", true); err != nil {
+		t.Errorf("Expected --yes to confirm, got %v", err)
+	}
+	if err := confirmByName("Delete?", "gpu", false); !errors.Is(err, errNonInteractive) {
+		t.Errorf("Expected errNonInteractive, got %v", err)
+	}
+}
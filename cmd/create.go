@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// createCmd represents the create command
+var createCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a runner group in an enterprise or organization",
+	Long: `Create a runner group in the specified enterprise or organization.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Access to the group is controlled with --visibility:
+- all: every repository (organization) or organization (enterprise) can use it
+- selected: only the repositories given with --selected-repo (organization)
+  or the organizations given with --selected-org (enterprise)
+- private: only private repositories (organization only)
+
+Repositories can be given as owner/repo, as a repository name in the
+organization, or by ID. Organizations can be given by login or by ID.
+
+Optional:
+- A hostname specified with the --hostname flag for GitHub Enterprise Server
+- The GH_HOST environment variable is also supported (handled by gh CLI)
+
+Examples:
+  # Create a group available to all repositories in an organization
+  gh-runner-group create linux-builders --org myorg
+
+  # Create a group for selected repositories
+  gh-runner-group create gpu --org myorg --visibility selected --selected-repo ml-training,myorg/inference
+
+  # Create an enterprise group for selected organizations
+  gh-runner-group create shared --enterprise myenterprise --visibility selected --selected-org myorg,otherorg
+
+  # Only allow a deployment workflow to use the group (implies --restricted-to-workflows)
+  gh-runner-group create deploy --org myorg \
+    --selected-workflow myorg/app/.github/workflows/deploy.yml@refs/heads/main
+
+  # Print the ID of the created group
+  gh-runner-group create linux-builders --org myorg --json id --jq .id`,
+	Args: cobra.ExactArgs(1),
+	Run:  runCreateCommand,
+}
+
+var (
+	createVisibility          string
+	createSelectedRepos       []string
+	createSelectedOrgs        []string
+	createAllowsPublicRepos   bool
+	createRestrictedWorkflows bool
+	createSelectedWorkflows   []string
+
+	createExport exportOptions
+)
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(createCmd, groupScopes...)
+
+	// Add the group settings flags
+	createCmd.Flags().StringVar(&createVisibility, "visibility", "", "Which repositories or organizations can use the group: {all|selected|private}")
+	createCmd.Flags().StringSliceVar(&createSelectedRepos, "selected-repo", nil, "Repository that can use the group with selected visibility (repeatable)")
+	createCmd.Flags().StringSliceVar(&createSelectedOrgs, "selected-org", nil, "Organization that can use the enterprise group with selected visibility (repeatable)")
+	createCmd.Flags().BoolVar(&createAllowsPublicRepos, "allows-public-repositories", false, "Allow public repositories to use the group")
+	createCmd.Flags().BoolVar(&createRestrictedWorkflows, "restricted-to-workflows", false, "Only allow the workflows given with --selected-workflow to use the group")
+	createCmd.Flags().StringSliceVar(&createSelectedWorkflows, "selected-workflow", nil, "Workflow that can use the group, as owner/repo/path@ref; implies --restricted-to-workflows (repeatable)")
+
+	_ = createCmd.RegisterFlagCompletionFunc("visibility", cobra.FixedCompletions(
+		[]string{runnergroup.VisibilityAll, runnergroup.VisibilitySelected, runnergroup.VisibilityPrivate}, cobra.ShellCompDirectiveNoFileComp))
+
+	// Add the --json, --jq and --template flags
+	addJSONFlags(createCmd, &createExport, runnergroup.RunnerGroupFields)
+}
+
+func runCreateCommand(cmd *cobra.Command, args []string) {
+	// Validate JSON output flags if provided
+	if err := createExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	req := runnergroup.CreateGroupRequest{
+		Name:                     args[0],
+		Visibility:               createVisibility,
+		AllowsPublicRepositories: createAllowsPublicRepos,
+		RestrictedToWorkflows:    createRestrictedWorkflows,
+		SelectedWorkflows:        createSelectedWorkflows,
+	}
+
+	// Selecting repositories or organizations implies selected visibility
+	if req.Visibility == "" && (len(createSelectedRepos) > 0 || len(createSelectedOrgs) > 0) {
+		req.Visibility = runnergroup.VisibilitySelected
+	}
+
+	// Selecting workflows implies restricting the group to them, as in edit
+	if len(req.SelectedWorkflows) > 0 && !cmd.Flags().Changed("restricted-to-workflows") {
+		req.RestrictedToWorkflows = true
+	}
+
+	// Fail on invalid settings before looking anything up
+	if err := runnergroup.ValidateVisibility(scope, req.Visibility); err != nil {
+		log.Fatal(err)
+	}
+	if len(createSelectedRepos) > 0 && scope.Kind != runnergroup.ScopeOrganization {
+		log.Fatal("--selected-repo can only be used with --org")
+	}
+	if len(createSelectedOrgs) > 0 && scope.Kind != runnergroup.ScopeEnterprise {
+		log.Fatal("--selected-org can only be used with --enterprise")
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the IDs of the selected repositories or organizations
+	req.SelectedRepositoryIDs, err = client.ResolveRepositoryIDs(ctx, scope, createSelectedRepos)
+	if err != nil {
+		log.Fatal(errorMessage(err, ""))
+	}
+	req.SelectedOrganizationIDs, err = client.ResolveOrganizationIDs(ctx, createSelectedOrgs)
+	if err != nil {
+		log.Fatal(errorMessage(err, ""))
+	}
+
+	group, err := client.CreateGroup(ctx, scope, req)
+	if err != nil {
+		log.Fatal(errorMessage(err, scope.String()))
+	}
+
+	// Output selected fields as JSON if requested
+	if createExport.enabled() {
+		if err := createExport.writeFields(os.Stdout, group); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Printf("Created runner group %s (ID %d) in %s with visibility %s\n", group.Name, group.ID, scope, group.Visibility)
+	if len(group.SelectedWorkflows) > 0 {
+		fmt.Printf("Restricted to workflows: %s\n", strings.Join(group.SelectedWorkflows, ", "))
+	}
+}
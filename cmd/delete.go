@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+// deleteCmd represents the delete command
+var deleteCmd = &cobra.Command{
+	Use:   "delete <runner-group>",
+	Short: "Delete a runner group",
+	Long: `Delete a runner group given by ID or name from an enterprise or organization.
+
+The default group and groups inherited from an enterprise cannot be deleted.
+Runners still in the group are moved to the default group by GitHub; the
+command warns before deleting such a group.
+
+When attached to a terminal, the command asks you to type the group name to
+confirm. Use --yes to skip the prompt, which is required when not running
+interactively, and --dry-run to see what would be deleted.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Optional:
+- A hostname specified with the --hostname flag for GitHub Enterprise Server
+- The GH_HOST environment variable is also supported (handled by gh CLI)
+
+Examples:
+  # Delete a group after typing its name
+  gh-runner-group delete linux-builders --org myorg
+
+  # Check what would happen without deleting anything
+  gh-runner-group delete 123 --enterprise myenterprise --dry-run
+
+  # Delete without prompting, e.g. in scripts
+  gh-runner-group delete 123 --org myorg --yes`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runDeleteCommand,
+}
+
+var (
+	deleteDryRun bool
+	deleteYes    bool
+)
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(deleteCmd, groupScopes...)
+
+	// Add the --dry-run and --yes flags
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "Show what would be deleted without deleting anything")
+	deleteCmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+}
+
+func runDeleteCommand(cmd *cobra.Command, args []string) {
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the runner group by ID or name
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	if err := runnergroup.CheckDeletable(*group); err != nil {
+		log.Fatal(err)
+	}
+
+	// Warn about runners that GitHub will move to the default group
+	runners, err := client.ListGroupRunners(ctx, scope, group.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+	if len(runners) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: runner group %s contains %s; GitHub will move them to the default group\n",
+			group.Name, text.Pluralize(len(runners), "runner"))
+	}
+
+	if deleteDryRun {
+		fmt.Printf("Would delete runner group %s (ID %d) from %s\n", group.Name, group.ID, scope)
+		return
+	}
+
+	if err := confirmByName(fmt.Sprintf("Delete runner group %s (ID %d) from %s?", group.Name, group.ID, scope), group.Name, deleteYes); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := client.DeleteGroup(ctx, scope, group.ID); err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	fmt.Printf("Deleted runner group %s (ID %d) from %s\n", group.Name, group.ID, scope)
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// editCmd represents the edit command
+var editCmd = &cobra.Command{
+	Use:   "edit <runner-group>",
+	Short: "Update or rename a runner group",
+	Long: `Change the name, visibility, public repository access or workflow
+restrictions of a runner group given by ID or name.
+
+Only the settings given by flags are changed. The command shows the fields
+that will change and asks for confirmation; use --yes to skip the prompt,
+which is required when not running interactively.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Optional:
+- A hostname specified with the --hostname flag for GitHub Enterprise Server
+- The GH_HOST environment variable is also supported (handled by gh CLI)
+
+Examples:
+  # Rename a group
+  gh-runner-group edit 123 --org myorg --name linux-x64
+
+  # Limit a group to selected repositories
+  gh-runner-group edit linux-x64 --org myorg --visibility selected
+
+  # Only allow a deployment workflow to use the group, without prompting
+  gh-runner-group edit deploy --org myorg --yes \
+    --selected-workflow myorg/app/.github/workflows/deploy.yml@refs/heads/main
+
+  # Lift the workflow restriction
+  gh-runner-group edit deploy --org myorg --restricted-to-workflows=false`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runEditCommand,
+}
+
+// editOptions holds the group settings flags of the edit command
+type editOptions struct {
+	name                  string
+	visibility            string
+	allowsPublicRepos     bool
+	restrictedToWorkflows bool
+	selectedWorkflows     []string
+}
+
+var (
+	editSettings editOptions
+	editYes      bool
+
+	editExport exportOptions
+)
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(editCmd, groupScopes...)
+
+	// Add the group settings flags; only flags given on the command line are applied
+	addEditFlags(editCmd, &editSettings)
+	editCmd.Flags().BoolVarP(&editYes, "yes", "y", false, "Apply the changes without asking for confirmation")
+
+	// Add the --json, --jq and --template flags
+	addJSONFlags(editCmd, &editExport, runnergroup.RunnerGroupFields)
+}
+
+// addEditFlags adds the group settings flags to a command
+func addEditFlags(cmd *cobra.Command, opts *editOptions) {
+	cmd.Flags().StringVar(&opts.name, "name", "", "New name of the group")
+	cmd.Flags().StringVar(&opts.visibility, "visibility", "", "Which repositories or organizations can use the group: {all|selected|private}")
+	cmd.Flags().BoolVar(&opts.allowsPublicRepos, "allows-public-repositories", false, "Allow public repositories to use the group")
+	cmd.Flags().BoolVar(&opts.restrictedToWorkflows, "restricted-to-workflows", false, "Only allow the workflows given with --selected-workflow to use the group")
+	cmd.Flags().StringSliceVar(&opts.selectedWorkflows, "selected-workflow", nil, "Workflow that can use the group, as owner/repo/path@ref; replaces the current list (repeatable)")
+
+	_ = cmd.RegisterFlagCompletionFunc("visibility", cobra.FixedCompletions(
+		[]string{runnergroup.VisibilityAll, runnergroup.VisibilitySelected, runnergroup.VisibilityPrivate}, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// request builds the update from the flags given on the command line
+func (o *editOptions) request(flags *pflag.FlagSet) runnergroup.UpdateGroupRequest {
+	var req runnergroup.UpdateGroupRequest
+
+	if flags.Changed("name") {
+		req.Name = &o.name
+	}
+	if flags.Changed("visibility") {
+		req.Visibility = &o.visibility
+	}
+	if flags.Changed("allows-public-repositories") {
+		req.AllowsPublicRepositories = &o.allowsPublicRepos
+	}
+	if flags.Changed("restricted-to-workflows") {
+		req.RestrictedToWorkflows = &o.restrictedToWorkflows
+	}
+	if flags.Changed("selected-workflow") {
+		req.SelectedWorkflows = &o.selectedWorkflows
+
+		// Selecting workflows implies restricting the group to them
+		if req.RestrictedToWorkflows == nil {
+			restricted := true
+			req.RestrictedToWorkflows = &restricted
+		}
+	}
+	return req
+}
+
+func runEditCommand(cmd *cobra.Command, args []string) {
+	// Validate JSON output flags if provided
+	if err := editExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	req := editSettings.request(cmd.Flags())
+	if req.Empty() {
+		log.Fatal("no changes requested; use --name, --visibility, --allows-public-repositories, --restricted-to-workflows or --selected-workflow")
+	}
+	if err := req.Validate(scope); err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the runner group by ID or name
+	before, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Show what will change before asking for confirmation
+	changes, err := runnergroup.DiffGroups(*before, req.Apply(*before))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(changes) == 0 {
+		fmt.Fprintf(os.Stderr, "Runner group %s (ID %d) already has these settings\n", before.Name, before.ID)
+		return
+	}
+	printChanges(os.Stderr, fmt.Sprintf("Changes to runner group %s (ID %d):", before.Name, before.ID), changes)
+
+	ok, err := confirm("Apply these changes?", editYes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !ok {
+		log.Fatal("cancelled")
+	}
+
+	after, err := client.UpdateGroup(ctx, scope, before.ID, req)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Output selected fields as JSON if requested
+	if editExport.enabled() {
+		if err := editExport.writeFields(os.Stdout, after); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Printf("Updated runner group %s (ID %d) in %s\n", after.Name, after.ID, scope)
+}
+
+// printChanges prints each changed field as "field: before -> after"
+func printChanges(w io.Writer, title string, changes []runnergroup.FieldChange) {
+	width := 0
+	for _, change := range changes {
+		width = max(width, len(change.Field)+1)
+	}
+
+	fmt.Fprintln(w, title)
+	for _, change := range changes {
+		fmt.Fprintf(w, "  %s  %s -> %s\n", text.PadRight(width, change.Field+":"),
+			runnergroup.FormatFieldValue(change.Before), runnergroup.FormatFieldValue(change.After))
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+func TestEditRequest(t *testing.T) {
+	tests := []struct {
+		name             string
+		flags            map[string]string
+		expectName       string
+		expectRestricted *bool
+		expectWorkflows  int
+		expectEmpty      bool
+		expectError      bool
+	}{
+		{name: "no flags", expectEmpty: true},
+		{name: "rename", flags: map[string]string{"name": "linux-x64"}, expectName: "linux-x64"},
+		{
+			name:             "selected workflows imply restriction",
+			flags:            map[string]string{"selected-workflow": "a/b/.github/workflows/c.yml@main"},
+			expectRestricted: boolPtr(true),
+			expectWorkflows:  1,
+		},
+		{
+			name:        "selected workflows without restriction",
+			flags:       map[string]string{"restricted-to-workflows": "false", "selected-workflow": "a/b/.github/workflows/c.yml@main"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			var opts editOptions
+			addEditFlags(cmd, &opts)
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatalf("Failed to set --%s: %v", name, err)
+				}
+			}
+
+			req := opts.request(cmd.Flags())
+			if req.Empty() != tt.expectEmpty {
+				t.Fatalf("Expected empty=%v, got %+v", tt.expectEmpty, req)
+			}
+			if tt.expectEmpty {
+				return
+			}
+			if err := req.Validate(runnergroup.OrganizationScope("myorg")); (err != nil) != tt.expectError {
+				t.Fatalf("Expected error=%v, got %v", tt.expectError, err)
+			}
+			if tt.expectName != "" && (req.Name == nil || *req.Name != tt.expectName) {
+				t.Errorf("Expected name %q, got %v", tt.expectName, req.Name)
+			}
+			if tt.expectRestricted != nil && (req.RestrictedToWorkflows == nil || *req.RestrictedToWorkflows != *tt.expectRestricted) {
+				t.Errorf("Expected restricted_to_workflows %v, got %v", *tt.expectRestricted, req.RestrictedToWorkflows)
+			}
+			if tt.expectWorkflows > 0 && (req.SelectedWorkflows == nil || len(*req.SelectedWorkflows) != tt.expectWorkflows) {
+				t.Errorf("Expected %d selected workflows, got %v", tt.expectWorkflows, req.SelectedWorkflows)
+			}
+		})
+	}
+}
+
+func TestPrintChanges(t *testing.T) {
+	changes := []runnergroup.FieldChange{
+		{Field: "name", Before: "deploy", After: "deploy-prod"},
+		{Field: "restricted_to_workflows", Before: false, After: true},
+	}
+
+	var buf bytes.Buffer
+	printChanges(&buf, "Changes to runner group deploy (ID 5):", changes)
+
+	expected := "Changes to runner group deploy (ID 5):\n" +
+		"  name:                     deploy -> deploy-prod\n" +
+		"  restricted_to_workflows:  false -> true\n"
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
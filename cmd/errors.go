@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+// errorMessage converts an API error into a message suitable for the user.
+// notFound describes what was being looked up and is used for 404 responses.
+func errorMessage(err error, notFound string) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Sprintf("timed out after %s; use --timeout to allow more time", timeout)
+	case errors.Is(err, context.Canceled):
+		return "interrupted"
+	}
+
+	var apiErr *runnergroup.APIError
+	if !errors.As(err, &apiErr) {
+		return err.Error()
+	}
+
+	var msg string
+	var unauthorized *runnergroup.UnauthorizedError
+	var forbidden *runnergroup.ForbiddenError
+	var notFoundErr *runnergroup.NotFoundError
+	var rateLimited *runnergroup.RateLimitedError
+
+	switch missing := apiErr.MissingScopes(); {
+	case errors.As(err, &unauthorized):
+		msg = "authentication failed; run `gh auth login` to authenticate"
+	case errors.As(err, &rateLimited):
+		msg = "API rate limit exceeded"
+		if !rateLimited.ResetAt.IsZero() {
+			msg += fmt.Sprintf("; try again after %s", rateLimited.ResetAt.Local().Format("15:04:05"))
+		}
+	case len(missing) > 0:
+		// GitHub answers 404 rather than 403 when the token lacks a required scope
+		msg = fmt.Sprintf("your token lacks the required scope (one of: %s); run `gh auth refresh -s %s` to grant it",
+			strings.Join(missing, ", "), missing[0])
+	case errors.As(err, &forbidden):
+		msg = "permission denied"
+		if apiErr.Message != "" {
+			msg += ": " + apiErr.Message
+		}
+	case errors.As(err, &notFoundErr) && notFound != "":
+		msg = fmt.Sprintf("%s does not exist or you do not have access to it", notFound)
+	default:
+		// Keep any context the caller wrapped around the API error
+		msg = err.Error()
+	}
+
+	if apiErr.DocumentationURL != "" {
+		msg += fmt.Sprintf("\nSee %s", apiErr.DocumentationURL)
+	}
+	return msg
+}
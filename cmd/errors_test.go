@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name:     "plain error",
+			err:      errors.New("invalid runner group ID: abc (must be a number)"),
+			expected: []string{"invalid runner group ID: abc (must be a number)"},
+		},
+		{
+			name: "not found",
+			err: &runnergroup.NotFoundError{APIError: &runnergroup.APIError{
+				StatusCode: 404,
+				Message:    "Not Found",
+			}},
+			expected: []string{"runner group 123 does not exist"},
+		},
+		{
+			name: "not found due to missing scope",
+			err: &runnergroup.NotFoundError{APIError: &runnergroup.APIError{
+				StatusCode:       404,
+				Message:          "Not Found",
+				DocumentationURL: "https://docs.github.com/rest",
+				AcceptedScopes:   []string{"admin:enterprise"},
+				TokenScopes:      []string{"repo"},
+			}},
+			expected: []string{"lacks the required scope", "admin:enterprise", "gh auth refresh -s admin:enterprise", "See https://docs.github.com/rest"},
+		},
+		{
+			name: "forbidden",
+			err: &runnergroup.ForbiddenError{APIError: &runnergroup.APIError{
+				StatusCode: 403,
+				Message:    "Must be an organization owner",
+			}},
+			expected: []string{"permission denied: Must be an organization owner"},
+		},
+		{
+			name:     "unauthorized",
+			err:      &runnergroup.UnauthorizedError{APIError: &runnergroup.APIError{StatusCode: 401}},
+			expected: []string{"gh auth login"},
+		},
+		{
+			name: "rate limited",
+			err: &runnergroup.RateLimitedError{
+				APIError: &runnergroup.APIError{StatusCode: 429},
+				ResetAt:  time.Now().Add(time.Minute),
+			},
+			expected: []string{"rate limit exceeded", "try again after"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := errorMessage(tt.err, "runner group 123")
+			for _, expected := range tt.expected {
+				if !strings.Contains(msg, expected) {
+					t.Errorf("Expected message to contain %q, got %q", expected, msg)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/jq"
+	"github.com/cli/go-gh/v2/pkg/jsonpretty"
+	"github.com/cli/go-gh/v2/pkg/template"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+// exportOptions holds the --json, --jq and --template flags of a command
+type exportOptions struct {
+	fields    []string
+	available []string
+	jqExpr    string
+	template  string
+}
+
+// addJSONFlags adds gh-style --json, --jq and --template flags to a command
+func addJSONFlags(cmd *cobra.Command, opts *exportOptions, available []string) {
+	opts.available = available
+
+	cmd.Flags().StringSliceVar(&opts.fields, "json", nil, "Output JSON with the specified `fields`")
+	cmd.Flags().StringVarP(&opts.jqExpr, "jq", "q", "", "Filter JSON output using a jq `expression`")
+	cmd.Flags().StringVarP(&opts.template, "template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
+	cmd.MarkFlagsMutuallyExclusive("jq", "template")
+
+	// Like gh, list the available fields when --json is given without a value
+	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		if strings.Contains(err.Error(), "flag needs an argument: --json") {
+			return fmt.Errorf("specify one or more comma-separated fields for `--json`:\n  %s", strings.Join(available, "\n  "))
+		}
+		return err
+	})
+}
+
+// enabled reports whether JSON output was requested
+func (o *exportOptions) enabled() bool {
+	return len(o.fields) > 0
+}
+
+// validate checks the requested fields and flag combinations
+func (o *exportOptions) validate() error {
+	if !o.enabled() {
+		if o.jqExpr != "" {
+			return fmt.Errorf("cannot use `--jq` without specifying `--json`")
+		}
+		if o.template != "" {
+			return fmt.Errorf("cannot use `--template` without specifying `--json`")
+		}
+		return nil
+	}
+	return runnergroup.ValidateFields(o.fields, o.available)
+}
+
+// writeFields writes the selected fields of v, a single value or a slice of
+// values, through write
+func (o *exportOptions) writeFields(w io.Writer, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice {
+		data, err := runnergroup.ExportFields(v, o.fields)
+		if err != nil {
+			return err
+		}
+		return o.write(w, data)
+	}
+
+	data := make([]map[string]interface{}, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		item, err := runnergroup.ExportFields(value.Index(i).Interface(), o.fields)
+		if err != nil {
+			return err
+		}
+		data = append(data, item)
+	}
+	return o.write(w, data)
+}
+
+// write encodes data as JSON and renders it through --jq or --template when given
+func (o *exportOptions) write(w io.Writer, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON: %v", err)
+	}
+
+	t := term.FromEnv()
+
+	switch {
+	case o.jqExpr != "":
+		return jq.EvaluateFormatted(bytes.NewReader(encoded), w, o.jqExpr, "  ", t.IsColorEnabled())
+	case o.template != "":
+		width, _, err := t.Size()
+		if err != nil {
+			width = 80
+		}
+		tmpl := template.New(w, width, t.IsColorEnabled())
+		if err := tmpl.Parse(o.template); err != nil {
+			return err
+		}
+		if err := tmpl.Execute(bytes.NewReader(encoded)); err != nil {
+			return err
+		}
+		return tmpl.Flush()
+	case t.IsTerminalOutput():
+		return jsonpretty.Format(w, bytes.NewReader(encoded), "  ", t.IsColorEnabled())
+	}
+
+	_, err = fmt.Fprintln(w, string(encoded))
+	return err
+}
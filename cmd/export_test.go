@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestExportOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        exportOptions
+		expectError string
+	}{
+		{
+			name: "no flags",
+			opts: exportOptions{available: runnergroup.RunnerFields},
+		},
+		{
+			name: "valid fields",
+			opts: exportOptions{fields: []string{"id", "name"}, available: runnergroup.RunnerFields},
+		},
+		{
+			name:        "unknown field",
+			opts:        exportOptions{fields: []string{"id", "nope"}, available: runnergroup.RunnerFields},
+			expectError: "unknown JSON field",
+		},
+		{
+			name:        "jq without json",
+			opts:        exportOptions{jqExpr: ".[]", available: runnergroup.RunnerFields},
+			expectError: "cannot use `--jq` without specifying `--json`",
+		},
+		{
+			name:        "template without json",
+			opts:        exportOptions{template: "{{.}}", available: runnergroup.RunnerFields},
+			expectError: "cannot use `--template` without specifying `--json`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.validate()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestExportOptions_Write(t *testing.T) {
+	data := []map[string]interface{}{
+		{"name": "runner-1", "busy": true},
+		{"name": "runner-2", "busy": false},
+	}
+
+	tests := []struct {
+		name     string
+		opts     exportOptions
+		expected string
+	}{
+		{
+			name:     "plain JSON",
+			opts:     exportOptions{fields: []string{"name", "busy"}},
+			expected: `[{"busy":true,"name":"runner-1"},{"busy":false,"name":"runner-2"}]` + "\n",
+		},
+		{
+			name:     "jq expression",
+			opts:     exportOptions{fields: []string{"name", "busy"}, jqExpr: ".[] | select(.busy) | .name"},
+			expected: "runner-1\n",
+		},
+		{
+			name:     "template",
+			opts:     exportOptions{fields: []string{"name"}, template: `{{range .}}{{.name}};{{end}}`},
+			expected: "runner-1;runner-2;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := tt.opts.write(&out, data); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"io"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/spf13/cobra"
+)
+
+// addFormatFlag adds the --format flag to a command
+func addFormatFlag(cmd *cobra.Command, format *string) {
+	cmd.Flags().StringVar(format, "format", runnergroup.DefaultFormat, "Output `format`: "+strings.Join(runnergroup.FormatterNames(), ", "))
+	cmd.MarkFlagsMutuallyExclusive("format", "json")
+
+	_ = cmd.RegisterFlagCompletionFunc("format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return runnergroup.FormatterNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// writeTable renders a table in the named format, using color, alignment and
+// truncation only when writing to a terminal
+func writeTable(w io.Writer, format string, table *runnergroup.Table) error {
+	formatter, err := runnergroup.LookupFormatter(format)
+	if err != nil {
+		return err
+	}
+
+	t := term.FromEnv()
+	opts := runnergroup.FormatOptions{
+		Color: t.IsColorEnabled(),
+		TTY:   t.IsTerminalOutput(),
+	}
+	if opts.TTY {
+		if width, _, err := t.Size(); err == nil {
+			opts.Width = width
+		}
+	}
+	return formatter.Format(w, table, opts)
+}
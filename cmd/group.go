@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout bounds the API call made while completing group names
+const completionTimeout = 5 * time.Second
+
+// completeGroupNames completes the runner group argument with the group names
+// of the enterprise or organization selected by flags
+func completeGroupNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil || !scope.SupportsRunnerGroups() || scope.Name == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	groups, err := newClient().ListGroups(ctx, scope)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveError
+	}
+
+	return groupCompletions(groups), cobra.ShellCompDirectiveNoFileComp
+}
+
+// groupCompletions returns a completion entry for each group name, described by its ID and visibility
+func groupCompletions(groups []runnergroup.RunnerGroup) []string {
+	completions := make([]string, 0, len(groups))
+	for _, group := range groups {
+		completions = append(completions, fmt.Sprintf("%s\tID %d, %s", group.Name, group.ID, group.Visibility))
+	}
+	return completions
+}
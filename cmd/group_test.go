@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestGroupCompletions(t *testing.T) {
+	groups := []runnergroup.RunnerGroup{
+		{ID: 1, Name: "Default", Visibility: "all"},
+		{ID: 5, Name: "gpu", Visibility: "selected"},
+	}
+
+	expected := []string{"Default\tID 1, all", "gpu\tID 5, selected"}
+	if completions := groupCompletions(groups); !reflect.DeepEqual(completions, expected) {
+		t.Errorf("Expected completions %q, got %q", expected, completions)
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// jitconfigCmd represents the jitconfig command
+var jitconfigCmd = &cobra.Command{
+	Use:   "jitconfig <runner-group>",
+	Short: "Generate a just-in-time runner configuration for a runner group",
+	Long: `Register a just-in-time runner in a runner group given by ID or name and
+print its encoded configuration. Start the runner with
+"./run.sh --jitconfig <config>"; it runs a single job and is then removed.
+
+Only the encoded configuration is printed. Use --json to also get the
+registered runner, e.g. its ID.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Optional:
+- A hostname specified with the --hostname flag for GitHub Enterprise Server
+- The GH_HOST environment variable is also supported (handled by gh CLI)
+
+Examples:
+  # Start an ephemeral runner in the gpu group
+  ./run.sh --jitconfig "$(gh-runner-group jitconfig gpu --org myorg --name gpu-$(hostname) --label self-hosted --label gpu)"
+
+  # Give the group by ID and use a custom work folder
+  gh-runner-group jitconfig 5 --org myorg --name ci-1 --label linux,x64 --work-folder /tmp/work
+
+  # Print the runner ID and configuration
+  gh-runner-group jitconfig gpu --org myorg --name ci-1 --label gpu --json runner,encoded_jit_config --jq '.runner.id'`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runJITConfigCommand,
+}
+
+var (
+	jitconfigName       string
+	jitconfigLabels     []string
+	jitconfigWorkFolder string
+
+	jitconfigExport exportOptions
+)
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(jitconfigCmd, groupScopes...)
+
+	// Add the runner settings flags
+	jitconfigCmd.Flags().StringVar(&jitconfigName, "name", "", "Name of the new runner")
+	jitconfigCmd.Flags().StringSliceVarP(&jitconfigLabels, "label", "l", nil, "Label of the new runner (repeatable)")
+	jitconfigCmd.Flags().StringVar(&jitconfigWorkFolder, "work-folder", "", "Working directory for jobs, relative to the runner directory (default \"_work\")")
+	_ = jitconfigCmd.MarkFlagRequired("name")
+	_ = jitconfigCmd.MarkFlagRequired("label")
+
+	// Add the --json, --jq and --template flags
+	addJSONFlags(jitconfigCmd, &jitconfigExport, runnergroup.JITConfigFields)
+}
+
+func runJITConfigCommand(cmd *cobra.Command, args []string) {
+	// Validate JSON output flags if provided
+	if err := jitconfigExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the runner group by ID or name
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	config, err := client.GenerateJITConfig(ctx, scope, runnergroup.JITConfigRequest{
+		Name:          jitconfigName,
+		RunnerGroupID: group.ID,
+		Labels:        jitconfigLabels,
+		WorkFolder:    jitconfigWorkFolder,
+	})
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Output selected fields as JSON if requested
+	if jitconfigExport.enabled() {
+		if err := jitconfigExport.writeFields(os.Stdout, config); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Println(config.EncodedJITConfig)
+}
@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+// labelsCmd represents the labels command
+var labelsCmd = &cobra.Command{
+	Use:   "labels",
+	Short: "Manage the custom labels of runners",
+	Long: `List, add, replace or remove the custom labels of self-hosted runners.
+
+A single runner is given by name or ID as the first argument. To change many
+runners with one command, select them with --runner (repeatable), with the
+--status, --name and label filters of the runners command, or both; filters
+then narrow down the runners given, and every argument is a label.
+Read-only default labels such as self-hosted, linux or x64 are assigned by
+GitHub and are never removed or replaced.
+
+Every subcommand requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+- A repository specified with the --repo flag`,
+}
+
+var labelsListCmd = &cobra.Command{
+	Use:   "list [<runner>...]",
+	Short: "List the labels of runners",
+	Long: `List the custom and default labels of the selected runners, or of every runner.
+
+Examples:
+  gh-runner-group labels list ci-arm-1 --org myorg
+  gh-runner-group labels list --org myorg --name '^ci-' --format csv`,
+	Args: cobra.ArbitraryArgs,
+	Run:  runLabelsListCommand,
+}
+
+var labelsAddCmd = &cobra.Command{
+	Use:   "add <runner> <label>...",
+	Short: "Add custom labels to runners",
+	Long: `Add custom labels to the selected runners. Runners that already have all
+the labels are skipped.
+
+Examples:
+  gh-runner-group labels add gpu-runner-1 gpu --org myorg
+
+  # Label every runner that was upgraded to Ubuntu 24.04
+  gh-runner-group labels add ubuntu-24.04 --org myorg --name '^ci-noble-' --dry-run`,
+	Args: labelArgs(1),
+	Run:  runLabelsAddCommand,
+}
+
+var labelsSetCmd = &cobra.Command{
+	Use:   "set <runner> [<label>...]",
+	Short: "Replace the custom labels of runners",
+	Long: `Replace all custom labels of the selected runners with the given labels.
+Default labels are kept. Without labels every custom label is removed; the
+command then asks for confirmation, use --yes to skip the prompt, which is
+required when not running interactively.
+
+Examples:
+  # Relabel runners after an OS upgrade
+  gh-runner-group labels set ubuntu-24.04 gpu --org myorg --label ubuntu-22.04 --name '^ci-'
+
+  # Remove all custom labels of a runner
+  gh-runner-group labels set ci-arm-1 --org myorg --yes`,
+	Args: labelArgs(0),
+	Run:  runLabelsSetCommand,
+}
+
+var labelsRemoveCmd = &cobra.Command{
+	Use:   "remove <runner> <label>...",
+	Short: "Remove custom labels from runners",
+	Long: `Remove custom labels from the selected runners. Runners without any of the
+labels are skipped. Removing a read-only default label is refused.
+
+Examples:
+  gh-runner-group labels remove ci-arm-1 gpu --org myorg
+  gh-runner-group labels remove ubuntu-22.04 --org myorg --label ubuntu-22.04`,
+	Args: labelArgs(1),
+	Run:  runLabelsRemoveCommand,
+}
+
+var (
+	labelsRunners []string
+	labelsDryRun  bool
+	labelsYes     bool
+
+	labelsListFormat string
+	labelsListExport exportOptions
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{labelsListCmd, labelsAddCmd, labelsSetCmd, labelsRemoveCmd} {
+		// Add the --enterprise, --org, --repo and --hostname flags
+		addScopeFlags(cmd, append(groupScopes, runnergroup.ScopeRepository)...)
+
+		// Add the --runner flag and the --status, --name and label filter flags
+		cmd.Flags().StringSliceVarP(&labelsRunners, "runner", "r", nil, "Runner name or ID (repeatable)")
+		addRunnerFilterFlags(cmd)
+
+		labelsCmd.AddCommand(cmd)
+	}
+
+	// Add the --json, --jq, --template and --format flags
+	addJSONFlags(labelsListCmd, &labelsListExport, runnergroup.RunnerFields)
+	addFormatFlag(labelsListCmd, &labelsListFormat)
+
+	// Add the --dry-run flag to the subcommands that change labels
+	for _, cmd := range []*cobra.Command{labelsAddCmd, labelsSetCmd, labelsRemoveCmd} {
+		cmd.Flags().BoolVar(&labelsDryRun, "dry-run", false, "Show which runners would change without changing anything")
+	}
+
+	// Add the --yes flag for removing all custom labels with set
+	labelsSetCmd.Flags().BoolVarP(&labelsYes, "yes", "y", false, "Remove all custom labels without asking for confirmation")
+}
+
+func runLabelsListCommand(cmd *cobra.Command, args []string) {
+	// Runners given as arguments are selected like --runner
+	labelsRunners = append(labelsRunners, args...)
+
+	// Validate JSON output flags if provided
+	if err := labelsListExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate output format
+	if _, err := runnergroup.LookupFormatter(labelsListFormat); err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate the status, name and label filters if provided
+	if _, err := validateRunnerFilters(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	runners := selectLabelRunners(ctx, client, scope)
+	runnergroup.SortRunners(runners)
+
+	// Output selected fields as JSON if requested
+	if labelsListExport.enabled() {
+		if err := labelsListExport.writeFields(os.Stdout, runners); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := writeTable(os.Stdout, labelsListFormat, runnergroup.RunnerLabelsTable(runners)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runLabelsAddCommand(cmd *cobra.Command, args []string) {
+	args = runnerFromArgs(args)
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, labelsRunners)
+	defer cancel()
+
+	// Skip runners that already have all the labels
+	var pending []runnergroup.Runner
+	for _, runner := range selectLabelRunners(ctx, client, scope) {
+		if hasAllLabels(runner, args) {
+			fmt.Fprintf(os.Stderr, "Skipping %s (ID %d): already has %s\n", runner.Name, runner.ID, strings.Join(args, ", "))
+			continue
+		}
+		pending = append(pending, runner)
+	}
+
+	change := runnerChange{
+		verb:   "label",
+		past:   "Labeled",
+		target: "with " + strings.Join(args, ", "),
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			_, err := client.AddRunnerLabels(ctx, scope, runner.ID, args)
+			return err
+		},
+	}
+	if err := change.run(ctx, pending, labelsDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runLabelsSetCommand(cmd *cobra.Command, args []string) {
+	args = runnerFromArgs(args)
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, labelsRunners)
+	defer cancel()
+
+	runners := selectLabelRunners(ctx, client, scope)
+
+	// Default labels cannot be set as custom labels; check before changing anything
+	for _, runner := range runners {
+		if err := runnergroup.CheckCustomLabels(runner, args); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	target := "with custom labels " + strings.Join(args, ", ")
+	if len(args) == 0 {
+		target = "without custom labels"
+
+		// Ask before removing every custom label
+		if !labelsDryRun && len(runners) > 0 {
+			ok, err := confirm(fmt.Sprintf("Remove all custom labels from %s?", text.Pluralize(len(runners), "runner")), labelsYes)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !ok {
+				log.Fatal("cancelled")
+			}
+		}
+	}
+
+	change := runnerChange{
+		verb:   "relabel",
+		past:   "Relabeled",
+		target: target,
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			_, err := client.SetRunnerLabels(ctx, scope, runner.ID, args)
+			return err
+		},
+	}
+	if err := change.run(ctx, runners, labelsDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runLabelsRemoveCommand(cmd *cobra.Command, args []string) {
+	args = runnerFromArgs(args)
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, labelsRunners)
+	defer cancel()
+
+	runners := selectLabelRunners(ctx, client, scope)
+
+	// Refuse to remove default labels before changing anything
+	for _, runner := range runners {
+		if err := runnergroup.CheckCustomLabels(runner, args); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Skip runners without any of the labels
+	var pending []runnergroup.Runner
+	for _, runner := range runners {
+		if len(runnergroup.MatchingLabels(runner, args)) == 0 {
+			fmt.Fprintf(os.Stderr, "Skipping %s (ID %d): has none of %s\n", runner.Name, runner.ID, strings.Join(args, ", "))
+			continue
+		}
+		pending = append(pending, runner)
+	}
+
+	change := runnerChange{
+		verb:   "relabel",
+		past:   "Relabeled",
+		target: "without " + strings.Join(args, ", "),
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			// Only remove the labels the runner has, by their exact names
+			for _, label := range runnergroup.MatchingLabels(runner, args) {
+				if _, err := client.RemoveRunnerLabel(ctx, scope, runner.ID, label.Name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	if err := change.run(ctx, pending, labelsDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// labelArgs requires at least min labels, plus the runner as the first
+// argument when neither --runner nor a filter selects runners
+func labelArgs(min int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(labelsRunners) > 0 || hasRunnerFilters() {
+			return cobra.MinimumNArgs(min)(cmd, args)
+		}
+		if len(args) == 0 {
+			return errNoRunnerSelection
+		}
+		return cobra.MinimumNArgs(min+1)(cmd, args)
+	}
+}
+
+// runnerFromArgs selects the runner given as the first argument when neither
+// --runner nor a filter selects runners, and returns the labels that follow
+func runnerFromArgs(args []string) []string {
+	if len(labelsRunners) > 0 || hasRunnerFilters() || len(args) == 0 {
+		return args
+	}
+	labelsRunners = append(labelsRunners, args[0])
+	return args[1:]
+}
+
+// selectLabelRunners returns the runners of the scope selected by --runner and the filter flags
+func selectLabelRunners(ctx context.Context, client *runnergroup.Client, scope runnergroup.Scope) []runnergroup.Runner {
+	candidates, err := client.ListRunners(ctx, scope)
+	if err != nil {
+		log.Fatal(errorMessage(err, scope.String()))
+	}
+
+	runners, err := selectRunners(candidates, labelsRunners)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return runners
+}
+
+// hasAllLabels reports whether the runner has every named label
+func hasAllLabels(runner runnergroup.Runner, names []string) bool {
+	for _, name := range names {
+		if !runnergroup.HasLabel(runner, name) {
+			return false
+		}
+	}
+	return true
+}
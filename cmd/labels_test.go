@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestHasAllLabels(t *testing.T) {
+	runner := runnergroup.Runner{Labels: []runnergroup.Label{{Name: "self-hosted"}, {Name: "GPU"}}}
+
+	if !hasAllLabels(runner, []string{"gpu", "self-hosted"}) {
+		t.Error("Expected the runner to have all labels")
+	}
+	if hasAllLabels(runner, []string{"gpu", "cuda12"}) {
+		t.Error("Expected the runner to miss cuda12")
+	}
+}
+
+func TestRunnerFromArgs(t *testing.T) {
+	defer func() { labelsRunners, nameFilter = nil, "" }()
+
+	tests := []struct {
+		name            string
+		runners         []string
+		nameFilter      string
+		args            []string
+		expectedLabels  []string
+		expectedRunners []string
+	}{
+		{"runner argument", nil, "", []string{"ci-arm-1", "gpu"}, []string{"gpu"}, []string{"ci-arm-1"}},
+		{"runner flag", []string{"ci-arm-1"}, "", []string{"gpu"}, []string{"gpu"}, []string{"ci-arm-1"}},
+		{"filter", nil, "^ci-", []string{"gpu"}, []string{"gpu"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelsRunners, nameFilter = tt.runners, tt.nameFilter
+
+			labels := runnerFromArgs(tt.args)
+			if !reflect.DeepEqual(labels, tt.expectedLabels) {
+				t.Errorf("Expected labels %v, got %v", tt.expectedLabels, labels)
+			}
+			if !reflect.DeepEqual(labelsRunners, tt.expectedRunners) {
+				t.Errorf("Expected runners %v, got %v", tt.expectedRunners, labelsRunners)
+			}
+		})
+	}
+}
+
+func TestLabelArgs(t *testing.T) {
+	defer func() { labelsRunners = nil }()
+
+	labelsRunners = nil
+	if err := labelArgs(1)(labelsAddCmd, []string{"ci-arm-1"}); err == nil {
+		t.Error("Expected an error for a runner without labels")
+	}
+	if err := labelArgs(1)(labelsAddCmd, []string{"ci-arm-1", "gpu"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := labelArgs(0)(labelsSetCmd, nil); err != errNoRunnerSelection {
+		t.Errorf("Expected %v, got %v", errNoRunnerSelection, err)
+	}
+
+	labelsRunners = []string{"ci-arm-1"}
+	if err := labelArgs(1)(labelsAddCmd, []string{"gpu"}); err != nil {
+		t.Errorf("Expected no error with --runner, got %v", err)
+	}
+}
@@ -1,8 +1,8 @@
 package cmd
 
 import (
-	"fmt"
 	"log"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
@@ -18,6 +18,9 @@ The command requires exactly one of:
 - An enterprise name specified with the --enterprise flag
 - An organization name specified with the --org flag
 
+Repositories have no runner groups. Passing --repo prints a hint to use
+the runners command, which lists the runners registered on the repository.
+
 Optional:
 - A hostname specified with the --hostname flag for GitHub Enterprise Server
 - The GH_HOST environment variable is also supported (handled by gh CLI)
@@ -29,6 +32,14 @@ Examples:
   # For GitHub.com organization
   gh-runner-group list --org myorg
 
+  # Output JSON for scripts (see --json without a value for the field list)
+  gh-runner-group list --org myorg --json id,name,visibility
+  gh-runner-group list --org myorg --json id,name,default --jq '.[] | select(.default | not) | .id'
+
+  # Output as CSV, TSV, YAML or a Markdown table
+  gh-runner-group list --org myorg --format csv
+  gh-runner-group list --org myorg --format markdown
+
   # For GitHub Enterprise Server (using flag)
   gh-runner-group list --enterprise myenterprise --hostname github.example.com
   gh-runner-group list --org myorg --hostname github.example.com
@@ -40,44 +51,65 @@ Examples:
 	Run:  runListCommand,
 }
 
-func init() {
-	// Add the --enterprise flag (shared with runners command)
-	listCmd.Flags().StringVarP(&enterpriseName, "enterprise", "e", "", "Enterprise name")
+var (
+	listExport exportOptions
+	listFormat string
+)
 
-	// Add the --org flag (shared with runners command)
-	listCmd.Flags().StringVarP(&orgName, "org", "o", "", "Organization name")
+func init() {
+	// Add the --enterprise, --org, --repo and --hostname flags (shared with runners command)
+	addScopeFlags(listCmd, append(groupScopes, runnergroup.ScopeRepository)...)
 
-	// Add the --hostname flag (shared with runners command)
-	listCmd.Flags().StringVarP(&hostname, "hostname", "H", "", "GitHub hostname (e.g., github.example.com)")
+	// Add the --json, --jq and --template flags
+	addJSONFlags(listCmd, &listExport, runnergroup.RunnerGroupFields)
 
-	// Make enterprise and org mutually exclusive, at least one is required
-	listCmd.MarkFlagsMutuallyExclusive("enterprise", "org")
-	listCmd.MarkFlagsOneRequired("enterprise", "org")
+	// Add the --format flag
+	addFormatFlag(listCmd, &listFormat)
 }
 
 func runListCommand(cmd *cobra.Command, args []string) {
-	// Create API client with optional hostname
-	// Only pass hostname if explicitly provided via flag (gh handles GH_HOST env var automatically)
-	client := runnergroup.NewClient()
-	if hostname != "" {
-		client.WithHostname(hostname)
+	// Validate JSON output flags if provided
+	if err := listExport.validate(); err != nil {
+		log.Fatal(err)
 	}
 
-	// Get runner groups using the client - choose enterprise or org API based on flags
-	var runnerGroups []runnergroup.RunnerGroup
-	var err error
-
-	if enterpriseName != "" {
-		runnerGroups, err = client.ListRunnerGroups(enterpriseName)
-	} else {
-		runnerGroups, err = client.ListOrgRunnerGroups(orgName)
+	// Validate output format
+	if _, err := runnergroup.LookupFormatter(listFormat); err != nil {
+		log.Fatal(err)
 	}
 
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Get runner groups in the enterprise or organization selected by flags
+	scope, err := scopeFromFlags()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// Format and print runner groups
-	output := runnergroup.FormatRunnerGroups(runnerGroups)
-	fmt.Println(output)
-}
\ No newline at end of file
+	// Repositories have no runner groups; point the user at their runners instead
+	if !scope.SupportsRunnerGroups() {
+		log.Fatalf("runner groups are not available for %s; use `gh runner-groups runners --repo %s` to list its runners", scope, scope.Name)
+	}
+
+	runnerGroups, err := client.ListGroups(ctx, scope)
+	if err != nil {
+		log.Fatal(errorMessage(err, scope.String()))
+	}
+
+	// Output selected fields as JSON if requested
+	if listExport.enabled() {
+		if err := listExport.writeFields(os.Stdout, runnerGroups); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// Print runner groups in the requested format
+	if err := writeTable(os.Stdout, listFormat, runnergroup.RunnerGroupsTable(runnerGroups)); err != nil {
+		log.Fatal(err)
+	}
+}
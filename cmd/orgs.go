@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// orgsCmd represents the orgs command
+var orgsCmd = &cobra.Command{
+	Use:   "orgs",
+	Short: "Manage the organizations that can use an enterprise runner group",
+	Long: `List, add, remove or replace the organizations that can use an enterprise
+runner group with selected visibility.
+
+Organizations can be given by login or by ID. The runner group can be given
+by ID or by name.
+
+Every subcommand requires an enterprise name specified with the --enterprise
+flag. Groups that are not limited to selected organizations have no
+organization list; change their visibility with the edit command first.`,
+}
+
+var orgsListCmd = &cobra.Command{
+	Use:   "list <runner-group>",
+	Short: "List the organizations that can use a runner group",
+	Long: `List the organizations selected to use an enterprise runner group.
+
+Examples:
+  gh-runner-group orgs list shared --enterprise myenterprise
+
+  # Output JSON for scripts (see --json without a value for the field list)
+  gh-runner-group orgs list shared --enterprise myenterprise --json login --jq '.[].login'`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               orgsAccess.runList,
+}
+
+var orgsAddCmd = &cobra.Command{
+	Use:   "add <runner-group> <organization>...",
+	Short: "Give organizations access to a runner group",
+	Long: `Add organizations to the organizations selected to use an enterprise runner group.
+
+Examples:
+  gh-runner-group orgs add shared myorg otherorg --enterprise myenterprise`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeGroupNames,
+	Run:               orgsAccess.runAdd,
+}
+
+var orgsRemoveCmd = &cobra.Command{
+	Use:   "remove <runner-group> <organization>...",
+	Short: "Remove the access of organizations to a runner group",
+	Long: `Remove organizations from the organizations selected to use an enterprise
+runner group. Repositories in those organizations can no longer use its runners.
+
+The command asks for confirmation; use --yes to skip the prompt, which is
+required when not running interactively.
+
+Examples:
+  gh-runner-group orgs remove shared oldorg --enterprise myenterprise --yes`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeGroupNames,
+	Run:               orgsAccess.runRemove,
+}
+
+var orgsSetCmd = &cobra.Command{
+	Use:   "set <runner-group> [<organization>...]",
+	Short: "Replace the organizations that can use a runner group",
+	Long: `Replace the organizations selected to use an enterprise runner group with
+the given organizations. Without organizations the selection is cleared and no
+organization can use the group's runners.
+
+The command shows the organizations that will be added and removed. When
+organizations lose access it asks for confirmation; use --yes to skip the
+prompt, which is required when not running interactively.
+
+Examples:
+  gh-runner-group orgs set shared myorg otherorg --enterprise myenterprise
+
+  # Remove the access of every organization
+  gh-runner-group orgs set shared --enterprise myenterprise --yes`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               orgsAccess.runSet,
+}
+
+// orgsAccess describes the organization list of enterprise runner groups
+var orgsAccess = &accessKind{
+	scopeKind: runnergroup.ScopeEnterprise,
+	noun:      "organization",
+	plural:    "organizations",
+	fields:    runnergroup.OrganizationFields,
+	resolve: func(client *runnergroup.Client, ctx context.Context, _ runnergroup.Scope, refs []string) ([]int, error) {
+		return client.ResolveOrganizationIDs(ctx, refs)
+	},
+	list: func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID int) (*accessList, error) {
+		orgs, err := client.ListGroupOrganizations(ctx, scope, groupID)
+		if err != nil {
+			return nil, err
+		}
+		list := &accessList{
+			table: runnergroup.OrganizationsTable(orgs),
+			items: orgs,
+		}
+		for _, org := range orgs {
+			list.entries = append(list.entries, accessEntry{ID: org.ID, Name: org.Login})
+		}
+		return list, nil
+	},
+	add:    (*runnergroup.Client).AddGroupOrganization,
+	remove: (*runnergroup.Client).RemoveGroupOrganization,
+	set:    (*runnergroup.Client).SetGroupOrganizations,
+}
+
+func init() {
+	orgsAccess.register(orgsCmd, orgsListCmd, orgsAddCmd, orgsRemoveCmd, orgsSetCmd)
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/cli/go-gh/v2/pkg/config"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+// pruneCmd represents the prune command
+var pruneCmd = &cobra.Command{
+	Use:   "prune <runner-group>",
+	Short: "Delete offline runners from a runner group",
+	Long: `Deregister the offline runners of a runner group given by ID or name.
+
+Runners can be narrowed down with --name (regular expression) and
+--older-than. GitHub does not report how long a runner has been offline, so
+every prune run records when it first sees each runner offline in a local
+history file. --older-than only deletes runners that were first seen offline
+at least that long ago; run prune with --dry-run regularly (e.g. from cron)
+to build up the history.
+
+The command lists the runners to delete and asks for confirmation; use --yes
+to skip the prompt, which is required when not running interactively. The IDs
+of the deleted runners are printed at the end.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Examples:
+  # See which offline runners would be deleted
+  gh-runner-group prune linux-builders --org myorg --dry-run
+
+  # Delete ci runners that have been offline for at least a week
+  gh-runner-group prune linux-builders --org myorg --name '^ci-' --older-than 168h --yes`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runPruneCommand,
+}
+
+var (
+	pruneName      string
+	pruneOlderThan time.Duration
+	pruneDryRun    bool
+	pruneYes       bool
+)
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(pruneCmd, groupScopes...)
+
+	// Add the runner selection flags
+	pruneCmd.Flags().StringVarP(&pruneName, "name", "n", "", "Only delete runners whose name matches (regular expression)")
+	pruneCmd.Flags().DurationVar(&pruneOlderThan, "older-than", 0, "Only delete runners first seen offline at least this long ago (e.g., 72h)")
+
+	// Add the --dry-run and --yes flags
+	pruneCmd.Flags().BoolVar(&pruneDryRun, "dry-run", false, "Show which runners would be deleted without deleting anything")
+	pruneCmd.Flags().BoolVarP(&pruneYes, "yes", "y", false, "Delete without asking for confirmation")
+}
+
+func runPruneCommand(cmd *cobra.Command, args []string) {
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate and compile name filter regex if provided
+	var nameRegex *regexp.Regexp
+	if pruneName != "" {
+		nameRegex, err = regexp.Compile(pruneName)
+		if err != nil {
+			log.Fatalf("Invalid regular expression for name filter: %v", err)
+		}
+	}
+	if pruneOlderThan < 0 {
+		log.Fatal("--older-than must not be negative")
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the runner group by ID or name
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	runners, err := client.ListGroupRunners(ctx, scope, group.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Record when runners were first seen offline, also on dry runs
+	host := apiHost()
+	historyPath := offlineHistoryPath()
+	history, err := runnergroup.LoadOfflineHistory(historyPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	now := time.Now()
+	history.Observe(host, scope, group.ID, runners, now)
+	if err := history.Save(historyPath); err != nil {
+		log.Fatal(err)
+	}
+
+	// Select offline runners matching the name and age filters
+	offline := runnergroup.FilterRunnersByStatus(runners, "offline")
+	if nameRegex != nil {
+		offline = runnergroup.FilterRunnersByName(offline, nameRegex)
+	}
+	runners = offline
+	if pruneOlderThan > 0 {
+		runners = history.FilterRunnersOfflineFor(host, scope, runners, pruneOlderThan, now)
+	}
+	runnergroup.SortRunners(runners)
+
+	if len(runners) == 0 {
+		// Offline runners seen for the first time only count once they have been offline long enough
+		if len(offline) > 0 {
+			fmt.Fprintf(os.Stderr, "Tracking %s in runner group %s (ID %d), but none has been offline for %s yet; nothing to prune\n",
+				text.Pluralize(len(offline), "offline runner"), group.Name, group.ID, pruneOlderThan)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "No offline runners to prune in runner group %s (ID %d)\n", group.Name, group.ID)
+		return
+	}
+
+	target := fmt.Sprintf("from %s", scope)
+	if pruneDryRun {
+		change := runnerChange{verb: "delete", target: target}
+		if err := change.run(ctx, runners, true); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// List the runners before asking for confirmation
+	fmt.Fprintf(os.Stderr, "Offline runners in runner group %s (ID %d):\n", group.Name, group.ID)
+	for _, runner := range runners {
+		since := "unknown"
+		if t, ok := history.OfflineSince(host, scope, runner); ok {
+			since = text.RelativeTimeAgo(now, t)
+		}
+		fmt.Fprintf(os.Stderr, "  %s (ID %d), first seen offline %s\n", runner.Name, runner.ID, since)
+	}
+
+	ok, err := confirm(fmt.Sprintf("Delete %s %s?", text.Pluralize(len(runners), "offline runner"), target), pruneYes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !ok {
+		log.Fatal("cancelled")
+	}
+
+	var deleted []string
+	change := runnerChange{
+		verb:   "delete",
+		past:   "Deleted",
+		target: target,
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			if err := client.DeleteRunner(ctx, scope, runner.ID); err != nil {
+				return err
+			}
+			history.Forget(host, scope, runner.ID)
+			deleted = append(deleted, fmt.Sprint(runner.ID))
+			return nil
+		},
+	}
+	runErr := change.run(ctx, runners, false)
+
+	// Report the deleted runners and drop them from the history even when some failed
+	if len(deleted) > 0 {
+		fmt.Printf("Deleted runner IDs: %s\n", strings.Join(deleted, ", "))
+	}
+	if err := history.Save(historyPath); err != nil {
+		log.Fatal(err)
+	}
+	if runErr != nil {
+		log.Fatal(runErr)
+	}
+}
+
+// apiHost returns the GitHub host the commands talk to
+func apiHost() string {
+	if hostname != "" {
+		return hostname
+	}
+	host, _ := auth.DefaultHost()
+	return host
+}
+
+// offlineHistoryPath returns the path of the file recording when runners were first seen offline
+func offlineHistoryPath() string {
+	return filepath.Join(config.StateDir(), "runner-groups", "offline-runners.json")
+}
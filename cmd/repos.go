@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// reposCmd represents the repos command
+var reposCmd = &cobra.Command{
+	Use:   "repos",
+	Short: "Manage the repositories that can use an organization runner group",
+	Long: `List, add, remove or replace the repositories that can use an organization
+runner group with selected visibility.
+
+Repositories can be given as owner/repo, as a repository name in the
+organization, or by ID. The runner group can be given by ID or by name.
+
+Every subcommand requires an organization name specified with the --org flag.
+Groups that are not limited to selected repositories have no repository list;
+change their visibility with the edit command first.`,
+}
+
+var reposListCmd = &cobra.Command{
+	Use:   "list <runner-group>",
+	Short: "List the repositories that can use a runner group",
+	Long: `List the repositories selected to use an organization runner group.
+
+Examples:
+  gh-runner-group repos list gpu --org myorg
+
+  # Output JSON for scripts (see --json without a value for the field list)
+  gh-runner-group repos list gpu --org myorg --json full_name --jq '.[].full_name'`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               reposAccess.runList,
+}
+
+var reposAddCmd = &cobra.Command{
+	Use:   "add <runner-group> <repository>...",
+	Short: "Give repositories access to a runner group",
+	Long: `Add repositories to the repositories selected to use an organization runner group.
+
+Examples:
+  # Let the ml-training repository use the gpu group
+  gh-runner-group repos add gpu ml-training --org myorg
+
+  # Add several repositories at once
+  gh-runner-group repos add gpu myorg/inference myorg/notebooks --org myorg`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeGroupNames,
+	Run:               reposAccess.runAdd,
+}
+
+var reposRemoveCmd = &cobra.Command{
+	Use:   "remove <runner-group> <repository>...",
+	Short: "Remove the access of repositories to a runner group",
+	Long: `Remove repositories from the repositories selected to use an organization
+runner group. Workflows in those repositories can no longer use its runners.
+
+The command asks for confirmation; use --yes to skip the prompt, which is
+required when not running interactively.
+
+Examples:
+  gh-runner-group repos remove gpu old-experiments --org myorg --yes`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeGroupNames,
+	Run:               reposAccess.runRemove,
+}
+
+var reposSetCmd = &cobra.Command{
+	Use:   "set <runner-group> [<repository>...]",
+	Short: "Replace the repositories that can use a runner group",
+	Long: `Replace the repositories selected to use an organization runner group with
+the given repositories. Without repositories the selection is cleared and no
+repository can use the group's runners.
+
+The command shows the repositories that will be added and removed. When
+repositories lose access it asks for confirmation; use --yes to skip the
+prompt, which is required when not running interactively.
+
+Examples:
+  gh-runner-group repos set gpu ml-training inference --org myorg
+
+  # Remove the access of every repository
+  gh-runner-group repos set gpu --org myorg --yes`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               reposAccess.runSet,
+}
+
+// reposAccess describes the repository list of organization runner groups
+var reposAccess = &accessKind{
+	scopeKind: runnergroup.ScopeOrganization,
+	noun:      "repository",
+	plural:    "repositories",
+	fields:    runnergroup.RepositoryFields,
+	resolve:   (*runnergroup.Client).ResolveRepositoryIDs,
+	list: func(client *runnergroup.Client, ctx context.Context, scope runnergroup.Scope, groupID int) (*accessList, error) {
+		repos, err := client.ListGroupRepositories(ctx, scope, groupID)
+		if err != nil {
+			return nil, err
+		}
+		list := &accessList{
+			table: runnergroup.RepositoriesTable(repos),
+			items: repos,
+		}
+		for _, repo := range repos {
+			list.entries = append(list.entries, accessEntry{ID: repo.ID, Name: repo.FullName})
+		}
+		return list, nil
+	},
+	add:    (*runnergroup.Client).AddGroupRepository,
+	remove: (*runnergroup.Client).RemoveGroupRepository,
+	set:    (*runnergroup.Client).SetGroupRepositories,
+	// Bare repository names are in the organization of the scope
+	qualify: func(scope runnergroup.Scope, ref string) string {
+		if strings.Contains(ref, "/") {
+			return ref
+		}
+		return scope.Name + "/" + ref
+	},
+}
+
+func init() {
+	reposAccess.register(reposCmd, reposListCmd, reposAddCmd, reposRemoveCmd, reposSetCmd)
+}
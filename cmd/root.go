@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"os"
+	"os/signal"
+	"time"
 
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
 	"github.com/spf13/cobra"
 )
 
@@ -16,6 +20,14 @@ and retrieving runner information in both Enterprise and Organization contexts.
 This tool allows you to:
 - List runner groups in enterprises or organizations
 - List runners in specific runner groups with status information
+- Move runners between runner groups
+- Manage the custom labels of runners
+- Delete stale offline runners
+- Create runner registration and removal tokens
+- Generate just-in-time runner configurations for runner groups
+- View the settings of a runner group
+- Create, update, rename and delete runner groups
+- Manage the repositories and organizations that can use runner groups
 - Format the output for further processing
 
 Supports both GitHub.com and GitHub Enterprise Server.`,
@@ -24,7 +36,11 @@ Supports both GitHub.com and GitHub Enterprise Server.`,
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	// Cancel in-flight API requests when the user presses Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -36,12 +52,55 @@ func init() {
 	// will be global for your application.
 
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.gh-runner-group.yaml)")
+
+	// Add the --max-retries flag (shared by all commands that call the API)
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "max-retries", runnergroup.DefaultMaxRetries, "Maximum number of retries for rate-limited or failed API requests")
+
+	// Add the --timeout flag (shared by all commands that call the API)
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Time limit for the whole command (e.g., 30s, 5m; 0 means no limit)")
+}
+
+var (
+	maxRetries int
+	timeout    time.Duration
+)
+
+// commandContext returns the command's context bounded by the --timeout flag
+func commandContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// newClient creates an API client configured from the command line flags
+func newClient() *runnergroup.Client {
+	// Only pass hostname if explicitly provided via flag (gh handles GH_HOST env var automatically)
+	client := runnergroup.NewClient().WithMaxRetries(maxRetries)
+	if hostname != "" {
+		client.WithHostname(hostname)
+	}
+	return client
 }
 
 // addSubcommands adds all subcommands to the root command
 func addSubcommands() {
 	rootCmd.AddCommand(runnersCmd)
 	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(viewCmd)
+	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(editCmd)
+	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(reposCmd)
+	rootCmd.AddCommand(orgsCmd)
+	rootCmd.AddCommand(labelsCmd)
+	rootCmd.AddCommand(pruneCmd)
+	rootCmd.AddCommand(tokenCmd)
+	rootCmd.AddCommand(jitconfigCmd)
 }
 
 func init() {
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"os"
 	"regexp"
 
 	"github.com/spf13/cobra"
@@ -11,13 +12,20 @@ import (
 
 // runnersCmd represents the runners command
 var runnersCmd = &cobra.Command{
-	Use:   "runners <runner-group-id>",
+	Use:   "runners <runner-group>",
 	Short: "List runners in a specific runner group",
-	Long: `List all runners in the specified runner group.
+	Long: `List all runners in the specified runner group, or all runners registered
+directly on a repository.
 
 The command requires:
 - Exactly one of: an enterprise name (--enterprise flag) OR an organization name (--org flag)
-- A runner group ID as a positional argument
+  OR a repository (--repo flag)
+- A runner group ID as a positional argument, or a runner group name matched
+  exactly first, then case-insensitively (not used with --repo, since
+  repositories have no runner groups)
+
+The names add, remove and move are subcommands, so a runner group with one of
+those names must be given by its ID, e.g. "runners 5 --org myorg".
 
 Optional:
 - A hostname specified with the --hostname flag for GitHub Enterprise Server
@@ -30,11 +38,33 @@ Examples:
   # For GitHub.com organization
   gh-runner-group runners 123 --org myorg
 
+  # By runner group name instead of ID
+  gh-runner-group runners linux-builders --org myorg
+
+  # For runners registered on a repository
+  gh-runner-group runners --repo myorg/myrepo
+
   # Filter by status
   gh-runner-group runners 123 --org myorg --status active
   gh-runner-group runners 123 --org myorg --status idle
   gh-runner-group runners 123 --org myorg --status offline
 
+  # Filter by labels (all of --label, at least one of --any-label, none of --exclude-label)
+  gh-runner-group runners 123 --org myorg --status idle --label linux --label arm64
+  gh-runner-group runners 123 --org myorg --any-label x64,arm64 --exclude-label gpu
+
+  # Output JSON for scripts (see --json without a value for the field list)
+  gh-runner-group runners 123 --org myorg --json id,name,status,busy,labels
+  gh-runner-group runners 123 --org myorg --json name,busy --jq '.[] | select(.busy) | .name'
+  gh-runner-group runners 123 --org myorg --json name,os --template '{{range .}}{{.name}} ({{.os}}){{"\n"}}{{end}}'
+
+  # Show OS, ephemeral flag and labels
+  gh-runner-group runners 123 --org myorg --wide
+
+  # Output as CSV, TSV, YAML or a Markdown table
+  gh-runner-group runners 123 --org myorg --wide --format csv > runners.csv
+  gh-runner-group runners 123 --org myorg --format markdown
+
   # Filter by name (regular expression)
   gh-runner-group runners 123 --org myorg --name "^prod-"
   gh-runner-group runners 123 --org myorg --name ".*ubuntu.*"
@@ -46,78 +76,155 @@ Examples:
   # For GitHub Enterprise Server (using environment variable)
   GH_HOST=github.example.com gh-runner-group runners 123 --enterprise myenterprise
   GH_HOST=github.example.com gh-runner-group runners 123 --org myorg`,
-	Args: cobra.ExactArgs(1),
-	Run:  runRunnersCommand,
+	Args:              cobra.RangeArgs(0, 1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runRunnersCommand,
 }
 
 var (
-	enterpriseName string
-	orgName        string
-	hostname       string
-	statusFilter   string
-	nameFilter     string
+	statusFilter string
+	nameFilter   string
+	wideOutput   bool
+
+	labelFilter        []string
+	anyLabelFilter     []string
+	excludeLabelFilter []string
+
+	runnersExport exportOptions
+	runnersFormat string
 )
 
 func init() {
-	// Add the --enterprise flag
-	runnersCmd.Flags().StringVarP(&enterpriseName, "enterprise", "e", "", "Enterprise name")
+	// Add the --enterprise, --org, --repo and --hostname flags
+	addScopeFlags(runnersCmd, append(groupScopes, runnergroup.ScopeRepository)...)
 
-	// Add the --org flag
-	runnersCmd.Flags().StringVarP(&orgName, "org", "o", "", "Organization name")
+	// Add the --status, --name and label filter flags
+	addRunnerFilterFlags(runnersCmd)
 
-	// Add the --hostname flag
-	runnersCmd.Flags().StringVarP(&hostname, "hostname", "H", "", "GitHub hostname (e.g., github.example.com)")
+	// Add the --wide flag
+	runnersCmd.Flags().BoolVarP(&wideOutput, "wide", "w", false, "Show OS, ephemeral flag and labels of each runner")
 
-	// Add the --status flag
-	runnersCmd.Flags().StringVarP(&statusFilter, "status", "s", "", "Filter by runner status (active, idle, offline)")
-
-	// Add the --name flag
-	runnersCmd.Flags().StringVarP(&nameFilter, "name", "n", "", "Filter by runner name (regular expression)")
+	// Add the --json, --jq and --template flags
+	addJSONFlags(runnersCmd, &runnersExport, runnergroup.RunnerFields)
 
-	// Make enterprise and org mutually exclusive, at least one is required
-	runnersCmd.MarkFlagsMutuallyExclusive("enterprise", "org")
-	runnersCmd.MarkFlagsOneRequired("enterprise", "org")
+	// Add the --format flag
+	addFormatFlag(runnersCmd, &runnersFormat)
 }
 
 func runRunnersCommand(cmd *cobra.Command, args []string) {
-	runnerGroupID := args[0]
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	// Validate status filter if provided
-	if statusFilter != "" && statusFilter != "active" && statusFilter != "idle" && statusFilter != "offline" {
-		log.Fatalf("Invalid status filter: %s. Valid options are: active, idle, offline", statusFilter)
+	// Repositories have no runner groups; other scopes require one
+	if scope.SupportsRunnerGroups() {
+		if len(args) != 1 {
+			log.Fatalf("a runner group ID or name is required for %s", scope)
+		}
+	} else if len(args) != 0 {
+		log.Fatalf("a runner group cannot be used with --repo")
 	}
 
-	// Validate and compile name filter regex if provided
-	var nameRegex *regexp.Regexp
-	if nameFilter != "" {
-		var err error
-		nameRegex, err = regexp.Compile(nameFilter)
+	// Validate JSON output flags if provided
+	if err := runnersExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate output format
+	if _, err := runnergroup.LookupFormatter(runnersFormat); err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate the status, name and label filters if provided
+	nameRegex, err := validateRunnerFilters()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Get runners in the group, or in the repository, selected by flags
+	var runners []runnergroup.Runner
+	if scope.SupportsRunnerGroups() {
+		group, err := client.ResolveGroup(ctx, scope, args[0])
+		if err != nil {
+			log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+		}
+		runners, err = client.ListGroupRunners(ctx, scope, group.ID)
 		if err != nil {
-			log.Fatalf("Invalid regular expression for name filter: %v", err)
+			log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+		}
+	} else {
+		runners, err = client.ListRunners(ctx, scope)
+		if err != nil {
+			log.Fatal(errorMessage(err, scope.String()))
 		}
 	}
 
-	// Create API client with optional hostname
-	// Only pass hostname if explicitly provided via flag (gh handles GH_HOST env var automatically)
-	client := runnergroup.NewClient()
-	if hostname != "" {
-		client.WithHostname(hostname)
+	// Filter runners by status, name and labels if specified
+	runners = applyRunnerFilters(runners, nameRegex)
+
+	// Sort runners by status (Active -> Idle -> Offline) then by name
+	runnergroup.SortRunners(runners)
+
+	// Output selected fields as JSON if requested
+	if runnersExport.enabled() {
+		if err := runnersExport.writeFields(os.Stdout, runners); err != nil {
+			log.Fatal(err)
+		}
+		return
 	}
 
-	// Get runners using the client - choose enterprise or org API based on flags
-	var runners []runnergroup.Runner
-	var err error
+	// Print runners in the requested format; the wide view adds OS, ephemeral flag and labels
+	if err := writeTable(os.Stdout, runnersFormat, runnergroup.RunnersTable(runners, wideOutput)); err != nil {
+		log.Fatal(err)
+	}
+}
 
-	if enterpriseName != "" {
-		runners, err = client.GetRunners(enterpriseName, runnerGroupID)
-	} else {
-		runners, err = client.GetOrgRunners(orgName, runnerGroupID)
+// addRunnerFilterFlags adds the --status, --name and label filter flags to a command
+func addRunnerFilterFlags(cmd *cobra.Command) {
+	// Add the --status flag
+	cmd.Flags().StringVarP(&statusFilter, "status", "s", "", "Filter by runner status (active, idle, offline)")
+
+	// Add the --name flag
+	cmd.Flags().StringVarP(&nameFilter, "name", "n", "", "Filter by runner name (regular expression)")
+
+	// Add the label filter flags
+	cmd.Flags().StringSliceVarP(&labelFilter, "label", "l", nil, "Filter by runner label; runners must have all given labels (repeatable)")
+	cmd.Flags().StringSliceVar(&anyLabelFilter, "any-label", nil, "Filter by runner label; runners must have at least one given label (repeatable)")
+	cmd.Flags().StringSliceVar(&excludeLabelFilter, "exclude-label", nil, "Exclude runners that have any given label (repeatable)")
+}
+
+// hasRunnerFilters reports whether any runner filter flag was given
+func hasRunnerFilters() bool {
+	return statusFilter != "" || nameFilter != "" || len(labelFilter) > 0 || len(anyLabelFilter) > 0 || len(excludeLabelFilter) > 0
+}
+
+// validateRunnerFilters checks the status filter and compiles the name filter, if given
+func validateRunnerFilters() (*regexp.Regexp, error) {
+	// Validate status filter if provided
+	if statusFilter != "" && statusFilter != "active" && statusFilter != "idle" && statusFilter != "offline" {
+		return nil, fmt.Errorf("Invalid status filter: %s. Valid options are: active, idle, offline", statusFilter)
 	}
 
+	// Validate and compile name filter regex if provided
+	if nameFilter == "" {
+		return nil, nil
+	}
+	nameRegex, err := regexp.Compile(nameFilter)
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("Invalid regular expression for name filter: %v", err)
 	}
+	return nameRegex, nil
+}
 
+// applyRunnerFilters returns the runners that match the status, name and label filters
+func applyRunnerFilters(runners []runnergroup.Runner, nameRegex *regexp.Regexp) []runnergroup.Runner {
 	// Filter runners by status if specified
 	if statusFilter != "" {
 		runners = runnergroup.FilterRunnersByStatus(runners, statusFilter)
@@ -128,17 +235,8 @@ func runRunnersCommand(cmd *cobra.Command, args []string) {
 		runners = runnergroup.FilterRunnersByName(runners, nameRegex)
 	}
 
-	// Sort runners by status (Active -> Idle -> Offline) then by name
-	runnergroup.SortRunners(runners)
-
-	// Calculate max name width for alignment
-	nameWidth := runnergroup.GetMaxRunnerNameLength(runners)
-
-	// Print aligned header
-	runnergroup.PrintHeaderAligned(nameWidth)
-
-	// Output with aligned colored status
-	for _, r := range runners {
-		fmt.Println(runnergroup.FormatRunnerWithStatusAligned(r, nameWidth))
-	}
-}
\ No newline at end of file
+	// Filter runners by labels if specified
+	runners = runnergroup.FilterRunnersByLabels(runners, labelFilter)
+	runners = runnergroup.FilterRunnersByAnyLabel(runners, anyLabelFilter)
+	return runnergroup.FilterRunnersExcludingLabels(runners, excludeLabelFilter)
+}
@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+// runnersAddCmd represents the runners add command
+var runnersAddCmd = &cobra.Command{
+	Use:   "add <runner-group> [runner...]",
+	Short: "Move runners into a runner group",
+	Long: `Move runners of the enterprise or organization into a runner group. A runner
+belongs to one group at a time, so it leaves its current group.
+
+Runners are selected by name or ID, by the --status, --name and label
+filters, or both; filters then narrow down the runners given. Runners
+already in the group are skipped. Use --dry-run to see what would change.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Examples:
+  # Move two runners into the gpu group
+  gh-runner-group runners add gpu gpu-runner-1 gpu-runner-2 --org myorg
+
+  # Move every runner with the gpu label into the gpu group
+  gh-runner-group runners add gpu --org myorg --label gpu --dry-run`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runRunnersAddCommand,
+}
+
+// runnersRemoveCmd represents the runners remove command
+var runnersRemoveCmd = &cobra.Command{
+	Use:   "remove <runner-group> [runner...]",
+	Short: "Return runners of a runner group to the default group",
+	Long: `Remove runners from a runner group. GitHub moves them back to the default group.
+
+Runners are selected by name or ID, by the --status, --name and label
+filters, or both. Use --dry-run to see what would change.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Examples:
+  gh-runner-group runners remove gpu gpu-runner-1 --org myorg
+
+  # Return all offline runners of a group to the default group
+  gh-runner-group runners remove gpu --org myorg --status offline`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runRunnersRemoveCommand,
+}
+
+// runnersMoveCmd represents the runners move command
+var runnersMoveCmd = &cobra.Command{
+	Use:   "move <from-group> <to-group> [runner...]",
+	Short: "Move runners from one runner group to another",
+	Long: `Move runners of a runner group into another runner group.
+
+Runners are selected by name or ID, by the --status, --name and label
+filters, or both. Use --dry-run to see what would change.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Examples:
+  # Move all idle ci-arm runners to the arm64 group
+  gh-runner-group runners move Default arm64 --org myorg --status idle --name '^ci-arm-'
+
+  # Move a single runner by ID
+  gh-runner-group runners move 2 5 42 --org myorg`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeMoveGroupNames,
+	Run:               runRunnersMoveCommand,
+}
+
+// errNoRunnerSelection is returned when neither runners nor filters are given
+var errNoRunnerSelection = errors.New("no runners selected; give runners by name or ID, or filter with --status, --name or --label")
+
+var runnersDryRun bool
+
+func init() {
+	for _, cmd := range []*cobra.Command{runnersAddCmd, runnersRemoveCmd, runnersMoveCmd} {
+		// Add the --enterprise, --org and --hostname flags
+		addScopeFlags(cmd, groupScopes...)
+
+		// Add the --status, --name and label filter flags
+		addRunnerFilterFlags(cmd)
+
+		// Add the --dry-run flag
+		cmd.Flags().BoolVar(&runnersDryRun, "dry-run", false, "Show which runners would change without changing anything")
+
+		runnersCmd.AddCommand(cmd)
+	}
+}
+
+// completeMoveGroupNames completes both the source and the target runner group
+func completeMoveGroupNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeGroupNames(cmd, nil, toComplete)
+}
+
+func runRunnersAddCommand(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, args[1:])
+	defer cancel()
+
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Any runner of the enterprise or organization can be added
+	candidates, err := client.ListRunners(ctx, scope)
+	if err != nil {
+		log.Fatal(errorMessage(err, scope.String()))
+	}
+	runners, err := selectRunners(candidates, args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Skip runners that are already in the group
+	var pending []runnergroup.Runner
+	for _, runner := range runners {
+		if runner.RunnerGroupID == group.ID {
+			fmt.Fprintf(os.Stderr, "Skipping %s (ID %d): already in runner group %s\n", runner.Name, runner.ID, group.Name)
+			continue
+		}
+		pending = append(pending, runner)
+	}
+
+	change := runnerChange{
+		verb:   "add",
+		past:   "Added",
+		target: fmt.Sprintf("to runner group %s (ID %d)", group.Name, group.ID),
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			return client.AddGroupRunner(ctx, scope, group.ID, runner.ID)
+		},
+	}
+	if err := change.run(ctx, pending, runnersDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runRunnersRemoveCommand(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, args[1:])
+	defer cancel()
+
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+	if group.Default {
+		log.Fatalf("runners cannot be removed from the default runner group %s; use `gh runner-groups runners move` to move them to another group", group.Name)
+	}
+
+	candidates, err := client.ListGroupRunners(ctx, scope, group.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+	runners, err := selectRunners(candidates, args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	change := runnerChange{
+		verb:   "remove",
+		past:   "Removed",
+		target: fmt.Sprintf("from runner group %s (ID %d) to the default group", group.Name, group.ID),
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			return client.RemoveGroupRunner(ctx, scope, group.ID, runner.ID)
+		},
+	}
+	if err := change.run(ctx, runners, runnersDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runRunnersMoveCommand(cmd *cobra.Command, args []string) {
+	scope, client, ctx, cancel := runnerSelectionSetup(cmd, args[2:])
+	defer cancel()
+
+	from, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+	to, err := client.ResolveGroup(ctx, scope, args[1])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[1], scope)))
+	}
+	if from.ID == to.ID {
+		log.Fatalf("runners are already in runner group %s; give two different groups", from.Name)
+	}
+
+	candidates, err := client.ListGroupRunners(ctx, scope, from.ID)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+	runners, err := selectRunners(candidates, args[2:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	change := runnerChange{
+		verb:   "move",
+		past:   "Moved",
+		target: fmt.Sprintf("from runner group %s (ID %d) to %s (ID %d)", from.Name, from.ID, to.Name, to.ID),
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			return client.AddGroupRunner(ctx, scope, to.ID, runner.ID)
+		},
+	}
+	if err := change.run(ctx, runners, runnersDryRun); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runnerSelectionSetup validates the runner selection and returns the scope, API
+// client and context shared by the commands that change selected runners
+func runnerSelectionSetup(cmd *cobra.Command, refs []string) (runnergroup.Scope, *runnergroup.Client, context.Context, context.CancelFunc) {
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Refuse to act on every runner when nothing was selected
+	if len(refs) == 0 && !hasRunnerFilters() {
+		log.Fatal(errNoRunnerSelection)
+	}
+	if _, err := validateRunnerFilters(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	return scope, client, ctx, cancel
+}
+
+// selectRunners returns the candidates given by name or ID, or all candidates
+// when none are given, narrowed down by the filter flags
+func selectRunners(candidates []runnergroup.Runner, refs []string) ([]runnergroup.Runner, error) {
+	nameRegex, err := validateRunnerFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	runners := candidates
+	if len(refs) > 0 {
+		runners, err = runnergroup.SelectRunners(candidates, refs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return applyRunnerFilters(runners, nameRegex), nil
+}
+
+// runnerChange applies the same group change to each selected runner
+type runnerChange struct {
+	verb   string // e.g. "move"
+	past   string // e.g. "Moved"
+	target string // e.g. "to runner group gpu (ID 5)"
+	apply  func(ctx context.Context, runner runnergroup.Runner) error
+}
+
+// run applies the change to each runner, printing one line per runner and a
+// summary. Failures are reported and the remaining runners are still changed.
+func (c runnerChange) run(ctx context.Context, runners []runnergroup.Runner, dryRun bool) error {
+	if len(runners) == 0 {
+		fmt.Fprintf(os.Stderr, "No runners matched; nothing to %s\n", c.verb)
+		return nil
+	}
+
+	if dryRun {
+		for _, runner := range runners {
+			fmt.Printf("Would %s %s (ID %d) %s\n", c.verb, runner.Name, runner.ID, c.target)
+		}
+		fmt.Printf("Would %s %s %s\n", c.verb, text.Pluralize(len(runners), "runner"), c.target)
+		return nil
+	}
+
+	failed := 0
+	for _, runner := range runners {
+		if err := c.apply(ctx, runner); err != nil {
+			// Stop when the command was interrupted or timed out
+			if ctx.Err() != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Failed to %s %s (ID %d): %s\n", c.verb, runner.Name, runner.ID, errorMessage(err, fmt.Sprintf("runner %s", runner.Name)))
+			failed++
+			continue
+		}
+		fmt.Printf("%s %s (ID %d) %s\n", c.past, runner.Name, runner.ID, c.target)
+	}
+
+	summary := fmt.Sprintf("%s %s %s", c.past, text.Pluralize(len(runners)-failed, "runner"), c.target)
+	if failed > 0 {
+		return fmt.Errorf("%s; %d failed", summary, failed)
+	}
+	fmt.Println(summary)
+	return nil
+}
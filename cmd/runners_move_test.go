@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestRunnerChange_Run(t *testing.T) {
+	runners := []runnergroup.Runner{{ID: 1, Name: "ci-arm-1"}, {ID: 2, Name: "ci-arm-2"}}
+
+	var applied []int
+	change := runnerChange{
+		verb:   "move",
+		past:   "Moved",
+		target: "to runner group arm64 (ID 5)",
+		apply: func(ctx context.Context, runner runnergroup.Runner) error {
+			applied = append(applied, runner.ID)
+			if runner.ID == 2 {
+				return errors.New("boom")
+			}
+			return nil
+		},
+	}
+
+	// A dry run changes nothing
+	if err := change.run(context.Background(), runners, true); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("Expected no changes in a dry run, got %v", applied)
+	}
+
+	// A failure does not stop the remaining runners and is reported in the summary
+	err := change.run(context.Background(), runners, false)
+	if len(applied) != 2 {
+		t.Errorf("Expected both runners to be changed, got %v", applied)
+	}
+	expected := "Moved 1 runner to runner group arm64 (ID 5); 1 failed"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestSelectRunners_Filters(t *testing.T) {
+	defer func() { statusFilter, nameFilter = "", "" }()
+	statusFilter, nameFilter = "idle", "^ci-arm-"
+
+	candidates := []runnergroup.Runner{
+		{ID: 1, Name: "ci-arm-1", Status: "online"},
+		{ID: 2, Name: "ci-arm-2", Status: "online", Busy: true},
+		{ID: 3, Name: "ci-x86-1", Status: "online"},
+	}
+
+	runners, err := selectRunners(candidates, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(runners) != 1 || runners[0].ID != 1 {
+		t.Errorf("Expected only the idle ci-arm runner, got %v", runners)
+	}
+}
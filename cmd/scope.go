@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+var (
+	enterpriseName string
+	orgName        string
+	repoName       string
+	hostname       string
+)
+
+// groupScopes are the scopes that have runner groups
+var groupScopes = []runnergroup.ScopeKind{runnergroup.ScopeEnterprise, runnergroup.ScopeOrganization}
+
+// addScopeFlags adds the --enterprise, --org and --repo flags for the given scopes
+// and the --hostname flag to a command. When more than one scope is accepted,
+// exactly one of their flags is required.
+func addScopeFlags(cmd *cobra.Command, kinds ...runnergroup.ScopeKind) {
+	var scopeFlags []string
+
+	for _, kind := range kinds {
+		switch kind {
+		case runnergroup.ScopeEnterprise:
+			// Add the --enterprise flag
+			cmd.Flags().StringVarP(&enterpriseName, "enterprise", "e", "", "Enterprise name")
+			scopeFlags = append(scopeFlags, "enterprise")
+		case runnergroup.ScopeOrganization:
+			// Add the --org flag
+			cmd.Flags().StringVarP(&orgName, "org", "o", "", "Organization name")
+			scopeFlags = append(scopeFlags, "org")
+		case runnergroup.ScopeRepository:
+			// Add the --repo flag
+			cmd.Flags().StringVarP(&repoName, "repo", "R", "", "Repository in owner/name format")
+			scopeFlags = append(scopeFlags, "repo")
+		}
+	}
+
+	// Add the --hostname flag
+	cmd.Flags().StringVarP(&hostname, "hostname", "H", "", "GitHub hostname (e.g., github.example.com)")
+
+	// Make the scope flags mutually exclusive, at least one is required
+	if len(scopeFlags) > 1 {
+		cmd.MarkFlagsMutuallyExclusive(scopeFlags...)
+	}
+	cmd.MarkFlagsOneRequired(scopeFlags...)
+}
+
+// scopeFromFlags returns the scope selected by the --enterprise, --org or --repo flag
+func scopeFromFlags() (runnergroup.Scope, error) {
+	switch {
+	case enterpriseName != "":
+		return runnergroup.EnterpriseScope(enterpriseName), nil
+	case repoName != "":
+		return runnergroup.ParseRepositoryScope(repoName)
+	}
+	return runnergroup.OrganizationScope(orgName), nil
+}
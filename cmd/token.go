@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/spf13/cobra"
+)
+
+// tokenCmd represents the token command
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Create tokens for registering and removing runners",
+	Long: `Create the short-lived tokens that the runner's config.sh uses to register
+a runner with, or remove it from, an enterprise, organization or repository.
+
+Only the token is printed, so it can be captured in scripts. Use --json
+token,expires_at to also get its expiry time.
+
+Every subcommand requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+- A repository specified with the --repo flag`,
+}
+
+var tokenRegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Create a runner registration token",
+	Long: `Create a token for registering a self-hosted runner.
+
+Examples:
+  ./config.sh --url https://github.com/myorg --token "$(gh-runner-group token register --org myorg)"
+
+  # Include the expiry time
+  gh-runner-group token register --repo myorg/myrepo --json token,expires_at`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runTokenCommand(cmd, (*runnergroup.Client).CreateRegistrationToken)
+	},
+}
+
+var tokenRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Create a runner removal token",
+	Long: `Create a token for removing a self-hosted runner.
+
+Examples:
+  ./config.sh remove --token "$(gh-runner-group token remove --org myorg)"`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runTokenCommand(cmd, (*runnergroup.Client).CreateRemoveToken)
+	},
+}
+
+var tokenExport exportOptions
+
+func init() {
+	for _, cmd := range []*cobra.Command{tokenRegisterCmd, tokenRemoveCmd} {
+		// Add the --enterprise, --org, --repo and --hostname flags
+		addScopeFlags(cmd, append(groupScopes, runnergroup.ScopeRepository)...)
+
+		// Add the --json, --jq and --template flags
+		addJSONFlags(cmd, &tokenExport, runnergroup.RunnerTokenFields)
+
+		tokenCmd.AddCommand(cmd)
+	}
+}
+
+// runTokenCommand creates a token with create and prints it
+func runTokenCommand(cmd *cobra.Command, create func(*runnergroup.Client, context.Context, runnergroup.Scope) (*runnergroup.RunnerToken, error)) {
+	// Validate JSON output flags if provided
+	if err := tokenExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	token, err := create(client, ctx, scope)
+	if err != nil {
+		log.Fatal(errorMessage(err, scope.String()))
+	}
+
+	// Output selected fields as JSON if requested
+	if tokenExport.enabled() {
+		if err := tokenExport.writeFields(os.Stdout, token); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Println(token.Token)
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/spf13/cobra"
+)
+
+// viewCmd represents the view command
+var viewCmd = &cobra.Command{
+	Use:   "view <runner-group>",
+	Short: "Show the details of a runner group",
+	Long: `Show all settings of a runner group, the number of runners in each status,
+the repositories or organizations it is shared with and its workflow restrictions.
+
+The runner group can be given by ID or by name. Names are matched exactly
+first, then case-insensitively.
+
+The command requires exactly one of:
+- An enterprise name specified with the --enterprise flag
+- An organization name specified with the --org flag
+
+Optional:
+- A hostname specified with the --hostname flag for GitHub Enterprise Server
+- The GH_HOST environment variable is also supported (handled by gh CLI)
+
+Examples:
+  # By ID or by name
+  gh-runner-group view 123 --org myorg
+  gh-runner-group view linux-builders --enterprise myenterprise
+
+  # Output JSON for scripts (see --json without a value for the field list)
+  gh-runner-group view 123 --org myorg --json name,runner_counts,selected_repositories
+
+  # For GitHub Enterprise Server
+  gh-runner-group view 123 --org myorg --hostname github.example.com`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeGroupNames,
+	Run:               runViewCommand,
+}
+
+var viewExport exportOptions
+
+func init() {
+	// Add the --enterprise, --org and --hostname flags
+	addScopeFlags(viewCmd, groupScopes...)
+
+	// Add the --json, --jq and --template flags
+	addJSONFlags(viewCmd, &viewExport, runnergroup.RunnerGroupDetailsFields)
+}
+
+func runViewCommand(cmd *cobra.Command, args []string) {
+	// Validate JSON output flags if provided
+	if err := viewExport.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	scope, err := scopeFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create API client with optional hostname and retry settings
+	client := newClient()
+
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	// Look up the runner group by ID or name
+	group, err := client.ResolveGroup(ctx, scope, args[0])
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Fetch runner counts and selected repositories or organizations
+	details, err := client.GetGroupDetails(ctx, scope, *group)
+	if err != nil {
+		log.Fatal(errorMessage(err, fmt.Sprintf("runner group %s in %s", args[0], scope)))
+	}
+
+	// Output selected fields as JSON if requested
+	if viewExport.enabled() {
+		if err := viewExport.writeFields(os.Stdout, details); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := printGroupDetails(os.Stdout, details); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// printGroupDetails prints a runner group as aligned "label: value" lines
+func printGroupDetails(w io.Writer, details *runnergroup.RunnerGroupDetails) error {
+	yesNo := func(b bool) string {
+		if b {
+			return "yes"
+		}
+		return "no"
+	}
+
+	counts := details.RunnerCounts
+	fields := [][2]string{
+		{"ID", fmt.Sprint(details.ID)},
+		{"Visibility", details.Visibility},
+		{"Default", yesNo(details.Default)},
+		{"Inherited", yesNo(details.Inherited)},
+		{"Allows public repositories", yesNo(details.AllowsPublicRepositories)},
+		{"Restricted to workflows", yesNo(details.RestrictedToWorkflows)},
+		{"Runners", fmt.Sprintf("%d (%d active, %d idle, %d offline)", counts.Total, counts.Active, counts.Idle, counts.Offline)},
+	}
+
+	var b strings.Builder
+	fmt.Fprintln(&b, details.Name)
+	for _, field := range fields {
+		fmt.Fprintf(&b, "%s  %s\n", text.PadRight(len("Allows public repositories:"), field[0]+":"), field[1])
+	}
+
+	// List values only when they apply to the group
+	writeList := func(title string, items []string) {
+		fmt.Fprintf(&b, "\n%s (%d):\n", title, len(items))
+		for _, item := range items {
+			fmt.Fprintf(&b, "  %s\n", item)
+		}
+	}
+	if details.RestrictedToWorkflows {
+		writeList("Selected workflows", details.SelectedWorkflows)
+	}
+	if details.SelectedRepositories != nil {
+		repos := make([]string, 0, len(details.SelectedRepositories))
+		for _, repo := range details.SelectedRepositories {
+			repos = append(repos, repo.FullName)
+		}
+		writeList("Selected repositories", repos)
+	}
+	if details.SelectedOrganizations != nil {
+		orgs := make([]string, 0, len(details.SelectedOrganizations))
+		for _, org := range details.SelectedOrganizations {
+			orgs = append(orgs, org.Login)
+		}
+		writeList("Selected organizations", orgs)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
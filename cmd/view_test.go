@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/buty4649/gh-runner-groups/pkg/runnergroup"
+)
+
+func TestPrintGroupDetails(t *testing.T) {
+	details := &runnergroup.RunnerGroupDetails{
+		RunnerGroup: runnergroup.RunnerGroup{
+			ID:                    5,
+			Name:                  "gpu",
+			Visibility:            "selected",
+			RestrictedToWorkflows: true,
+			SelectedWorkflows:     []string{"myorg/app/.github/workflows/deploy.yml@main"},
+		},
+		RunnerCounts:         runnergroup.RunnerCounts{Total: 3, Active: 1, Idle: 1, Offline: 1},
+		SelectedRepositories: []runnergroup.Repository{{FullName: "myorg/app"}},
+	}
+
+	var buf bytes.Buffer
+	if err := printGroupDetails(&buf, details); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := `gpu
+ID:                          5
+Visibility:                  selected
+Default:                     no
+Inherited:                   no
+Allows public repositories:  no
+Restricted to workflows:     yes
+Runners:                     3 (1 active, 1 idle, 1 offline)
+
+Selected workflows (1):
+  myorg/app/.github/workflows/deploy.yml@main
+
+Selected repositories (1):
+  myorg/app
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
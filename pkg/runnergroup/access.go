@@ -0,0 +1,123 @@
+package runnergroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// groupAccess describes a list of repositories or organizations that a runner
+// group with selected visibility is shared with
+type groupAccess struct {
+	// kind and owner name the scope whose runner groups have this list
+	kind  ScopeKind
+	owner string
+	// collection is the endpoint segment and the noun used in errors, e.g. "repositories"
+	collection string
+	// idsField is the request field that replaces the whole list
+	idsField string
+}
+
+var (
+	repositoryAccess   = groupAccess{kind: ScopeOrganization, owner: "organization", collection: "repositories", idsField: "selected_repository_ids"}
+	organizationAccess = groupAccess{kind: ScopeEnterprise, owner: "enterprise", collection: "organizations", idsField: "selected_organization_ids"}
+)
+
+// validate reports an error for a scope whose groups are not shared this way
+func (a groupAccess) validate(scope Scope) error {
+	if scope.Kind != a.kind {
+		return fmt.Errorf("selected %s are only available for %s runner groups", a.collection, a.owner)
+	}
+	return nil
+}
+
+// endpoint returns the path of the list of a runner group
+func (a groupAccess) endpoint(scope Scope, groupID int) string {
+	return fmt.Sprintf("%s/actions/runner-groups/%d/%s", scope.Path(), groupID, a.collection)
+}
+
+// ListGroupRepositories fetches the repositories selected to use an organization runner group
+func (c *Client) ListGroupRepositories(ctx context.Context, scope Scope, groupID int) ([]Repository, error) {
+	if err := repositoryAccess.validate(scope); err != nil {
+		return nil, err
+	}
+	return paginate(ctx, c, repositoryAccess.endpoint(scope, groupID), c.Options.MaxItems, decodeRepositories)
+}
+
+// AddGroupRepository gives a repository access to an organization runner group
+func (c *Client) AddGroupRepository(ctx context.Context, scope Scope, groupID, repoID int) error {
+	return c.changeGroupAccess(ctx, repositoryAccess, http.MethodPut, scope, groupID, repoID)
+}
+
+// RemoveGroupRepository removes the access of a repository to an organization runner group
+func (c *Client) RemoveGroupRepository(ctx context.Context, scope Scope, groupID, repoID int) error {
+	return c.changeGroupAccess(ctx, repositoryAccess, http.MethodDelete, scope, groupID, repoID)
+}
+
+// SetGroupRepositories replaces the repositories that can use an organization runner group
+func (c *Client) SetGroupRepositories(ctx context.Context, scope Scope, groupID int, repoIDs []int) error {
+	return c.setGroupAccess(ctx, repositoryAccess, scope, groupID, repoIDs)
+}
+
+// ListGroupOrganizations fetches the organizations selected to use an enterprise runner group
+func (c *Client) ListGroupOrganizations(ctx context.Context, scope Scope, groupID int) ([]Organization, error) {
+	if err := organizationAccess.validate(scope); err != nil {
+		return nil, err
+	}
+	return paginate(ctx, c, organizationAccess.endpoint(scope, groupID), c.Options.MaxItems, decodeOrganizations)
+}
+
+// AddGroupOrganization gives an organization access to an enterprise runner group
+func (c *Client) AddGroupOrganization(ctx context.Context, scope Scope, groupID, orgID int) error {
+	return c.changeGroupAccess(ctx, organizationAccess, http.MethodPut, scope, groupID, orgID)
+}
+
+// RemoveGroupOrganization removes the access of an organization to an enterprise runner group
+func (c *Client) RemoveGroupOrganization(ctx context.Context, scope Scope, groupID, orgID int) error {
+	return c.changeGroupAccess(ctx, organizationAccess, http.MethodDelete, scope, groupID, orgID)
+}
+
+// SetGroupOrganizations replaces the organizations that can use an enterprise runner group
+func (c *Client) SetGroupOrganizations(ctx context.Context, scope Scope, groupID int, orgIDs []int) error {
+	return c.setGroupAccess(ctx, organizationAccess, scope, groupID, orgIDs)
+}
+
+// changeGroupAccess adds (PUT) or removes (DELETE) a single entry of the list of a runner group
+func (c *Client) changeGroupAccess(ctx context.Context, access groupAccess, method string, scope Scope, groupID, id int) error {
+	if err := access.validate(scope); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/%d", access.endpoint(scope, groupID), id)
+	return c.SendJSON(ctx, method, endpoint, nil, nil)
+}
+
+// setGroupAccess replaces the list of a runner group
+func (c *Client) setGroupAccess(ctx context.Context, access groupAccess, scope Scope, groupID int, ids []int) error {
+	if err := access.validate(scope); err != nil {
+		return err
+	}
+
+	// Always send the list, even when empty, as the API requires it
+	body := map[string][]int{access.idsField: append([]int{}, ids...)}
+	return c.SendJSON(ctx, http.MethodPut, access.endpoint(scope, groupID), body, nil)
+}
+
+// decodeRepositories decodes a page of repositories and the reported total count
+func decodeRepositories(data []byte) ([]Repository, int, error) {
+	var response RepositoriesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse JSON response: %v", err)
+	}
+	return response.Repositories, response.TotalCount, nil
+}
+
+// decodeOrganizations decodes a page of organizations and the reported total count
+func decodeOrganizations(data []byte) ([]Organization, int, error) {
+	var response OrganizationsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse JSON response: %v", err)
+	}
+	return response.Organizations, response.TotalCount, nil
+}
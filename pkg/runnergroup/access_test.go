@@ -0,0 +1,84 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGroupAccessChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  Scope
+		path   string
+		add    func(*Client, context.Context, Scope) error
+		remove func(*Client, context.Context, Scope) error
+		set    func(*Client, context.Context, Scope) error
+		body   string
+	}{
+		{
+			name:   "repositories",
+			scope:  OrganizationScope("test-org"),
+			path:   "/orgs/test-org/actions/runner-groups/5/repositories",
+			add:    func(c *Client, ctx context.Context, s Scope) error { return c.AddGroupRepository(ctx, s, 5, 11) },
+			remove: func(c *Client, ctx context.Context, s Scope) error { return c.RemoveGroupRepository(ctx, s, 5, 11) },
+			set:    func(c *Client, ctx context.Context, s Scope) error { return c.SetGroupRepositories(ctx, s, 5, nil) },
+			// An empty list is sent explicitly to clear the selection
+			body: `{"selected_repository_ids":[]}`,
+		},
+		{
+			name:   "organizations",
+			scope:  EnterpriseScope("test-enterprise"),
+			path:   "/enterprises/test-enterprise/actions/runner-groups/5/organizations",
+			add:    func(c *Client, ctx context.Context, s Scope) error { return c.AddGroupOrganization(ctx, s, 5, 11) },
+			remove: func(c *Client, ctx context.Context, s Scope) error { return c.RemoveGroupOrganization(ctx, s, 5, 11) },
+			set: func(c *Client, ctx context.Context, s Scope) error {
+				return c.SetGroupOrganizations(ctx, s, 5, []int{11, 12})
+			},
+			body: `{"selected_organization_ids":[11,12]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noContent := &Response{StatusCode: http.StatusNoContent, Header: http.Header{}}
+			fake := &fakeTransport{responses: map[string]*Response{
+				tt.path:         noContent,
+				tt.path + "/11": noContent,
+			}}
+			client := NewClient().WithTransport(fake)
+			ctx := context.Background()
+
+			for _, change := range []func(*Client, context.Context, Scope) error{tt.add, tt.remove, tt.set} {
+				if err := change(client, ctx, tt.scope); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			expected := []Request{
+				{Method: http.MethodPut, Path: tt.path + "/11"},
+				{Method: http.MethodDelete, Path: tt.path + "/11"},
+				{Method: http.MethodPut, Path: tt.path, Body: []byte(tt.body)},
+			}
+			if len(fake.requests) != len(expected) {
+				t.Fatalf("Expected %d requests, got %d", len(expected), len(fake.requests))
+			}
+			for i, want := range expected {
+				sent := fake.requests[i]
+				if sent.Method != want.Method || sent.Path != want.Path || string(sent.Body) != string(want.Body) {
+					t.Errorf("Request %d: expected %s %s %q, got %s %s %q", i, want.Method, want.Path, want.Body, sent.Method, sent.Path, sent.Body)
+				}
+			}
+
+			// Groups of the other scope kind have no such list
+			wrongScope := OrganizationScope("test-org")
+			if tt.scope.Kind == ScopeOrganization {
+				wrongScope = EnterpriseScope("test-enterprise")
+			}
+			if err := tt.add(client, ctx, wrongScope); err == nil || !strings.Contains(err.Error(), "only available for") {
+				t.Errorf("Expected scope error, got %v", err)
+			}
+		})
+	}
+}
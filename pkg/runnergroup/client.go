@@ -1,15 +1,28 @@
 package runnergroup
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-
-	"github.com/cli/go-gh/v2"
+	"net/http"
+	"sync"
+	"time"
 )
 
-// Client represents a GitHub API client with configured options
+// Client represents a GitHub API client with configured options.
+// Once configured, a Client is safe for concurrent use; the With methods
+// must not be called while requests are in flight.
 type Client struct {
-	Options Options
+	Options   Options
+	Transport Transport
+
+	// sleep waits between retries; replaced in tests
+	sleep func(ctx context.Context, d time.Duration) error
+
+	// mu guards the lazily created Transport and rateLimitResetAt
+	mu sync.Mutex
+	// rateLimitResetAt is when the exhausted primary rate limit resets
+	rateLimitResetAt time.Time
 }
 
 // NewClient creates a new GitHub API client with default options
@@ -20,8 +33,10 @@ func NewClient() *Client {
 				"Accept":               "application/vnd.github+json",
 				"X-GitHub-Api-Version": "2022-11-28",
 			},
-			Paginate: false, // Disable automatic pagination, use manual pagination instead
+			Paginate:   false, // Disable automatic pagination, use manual pagination instead
+			MaxRetries: DefaultMaxRetries,
 		},
+		sleep: sleepContext,
 	}
 }
 
@@ -31,36 +46,160 @@ func (c *Client) WithHostname(hostname string) *Client {
 	return c
 }
 
+// WithMaxRetries sets how many times rate-limited or failed requests are retried
+func (c *Client) WithMaxRetries(maxRetries int) *Client {
+	c.Options.MaxRetries = maxRetries
+	return c
+}
+
+// WithTransport sets the transport used to send API requests
+func (c *Client) WithTransport(transport Transport) *Client {
+	c.Transport = transport
+	return c
+}
+
+// defaultTransport prefers the in-process REST backend and falls back to
+// running `gh api` when no gh authentication is available
+func (c *Client) defaultTransport() Transport {
+	if transport, err := NewRESTTransport(c.Options.Hostname, c.Options.Headers); err == nil {
+		return transport
+	}
+	return &ExecTransport{}
+}
+
 // CallAPI makes a GitHub API call and returns the raw response using the client's options
 func (c *Client) CallAPI(endpoint string) ([]byte, error) {
-	args := []string{"api"}
+	return c.CallAPIContext(context.Background(), endpoint)
+}
 
-	// Add headers
-	for key, value := range c.Options.Headers {
-		args = append(args, "-H", fmt.Sprintf("%s: %s", key, value))
+// CallAPIContext is like CallAPI but honors cancellation of ctx
+func (c *Client) CallAPIContext(ctx context.Context, endpoint string) ([]byte, error) {
+	resp, err := c.Do(ctx, &Request{Method: http.MethodGet, Path: endpoint})
+	if err != nil {
+		return nil, err
 	}
 
-	// Add hostname if specified
-	if c.Options.Hostname != "" {
-		args = append(args, "--hostname", c.Options.Hostname)
+	return resp.Body, nil
+}
+
+// Do sends a request through the client's transport, applying the client's options.
+// Cancelling ctx aborts the request and any pending retry.
+func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
+	transport := c.transport()
+
+	// Merge client headers with request-specific headers
+	headers := make(map[string]string, len(c.Options.Headers)+len(req.Headers))
+	for key, value := range c.Options.Headers {
+		headers[key] = value
+	}
+	for key, value := range req.Headers {
+		headers[key] = value
 	}
 
 	// Note: Manual pagination is handled in the caller, not here
+	transportReq := &Request{
+		Method:   req.Method,
+		Path:     req.Path,
+		Headers:  headers,
+		Hostname: c.Options.Hostname,
+		Body:     req.Body,
+	}
 
-	// Add endpoint
-	args = append(args, endpoint)
+	// Wait for an exhausted rate limit to reset before sending more requests
+	if wait := time.Until(c.rateLimitResetTime()); wait > 0 && wait <= maxRetryDelay {
+		if err := c.wait(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
 
-	stdout, stderr, err := gh.Exec(args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute gh command: %v\nStderr: %s", err, stderr.String())
+	for attempt := 0; ; attempt++ {
+		resp, err := transport.Do(ctx, transportReq)
+		if err != nil {
+			return nil, err
+		}
+
+		c.trackRateLimit(resp)
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		delay, retry := retryDelay(resp, attempt, time.Now())
+
+		// A POST may have taken effect before the server failed; never send it twice
+		if req.Method == http.MethodPost && resp.StatusCode >= 500 {
+			retry = false
+		}
+		if !retry || attempt >= c.Options.MaxRetries {
+			return nil, newAPIError(resp, req.Path)
+		}
+
+		if err := c.wait(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// transport returns the client's transport, creating the default one on first use
+func (c *Client) transport() Transport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Transport == nil {
+		c.Transport = c.defaultTransport()
+	}
+	return c.Transport
+}
+
+// trackRateLimit records when the primary rate limit resets once it is exhausted
+func (c *Client) trackRateLimit(resp *Response) {
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+	if reset, ok := rateLimitReset(resp.Header); ok {
+		c.mu.Lock()
+		c.rateLimitResetAt = reset.Add(time.Second)
+		c.mu.Unlock()
 	}
+}
 
-	return stdout.Bytes(), nil
+// rateLimitResetTime returns when the exhausted primary rate limit resets, if known
+func (c *Client) rateLimitResetTime() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimitResetAt
+}
+
+// wait pauses for the given duration or until ctx is done
+func (c *Client) wait(ctx context.Context, d time.Duration) error {
+	sleep := c.sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	return sleep(ctx, d)
+}
+
+// sleepContext pauses for the given duration, returning early with the context error
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 // CallAPIWithJSON makes a GitHub API call and unmarshals the JSON response using the client's options
 func (c *Client) CallAPIWithJSON(endpoint string, result interface{}) error {
-	data, err := c.CallAPI(endpoint)
+	return c.CallAPIWithJSONContext(context.Background(), endpoint, result)
+}
+
+// CallAPIWithJSONContext is like CallAPIWithJSON but honors cancellation of ctx
+func (c *Client) CallAPIWithJSONContext(ctx context.Context, endpoint string, result interface{}) error {
+	data, err := c.CallAPIContext(ctx, endpoint)
 	if err != nil {
 		return err
 	}
@@ -70,4 +209,32 @@ func (c *Client) CallAPIWithJSON(endpoint string, result interface{}) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// SendJSON makes a GitHub API call with body encoded as JSON and, when result
+// is not nil, unmarshals the JSON response into it. A nil body sends no content.
+func (c *Client) SendJSON(ctx context.Context, method, endpoint string, body, result interface{}) error {
+	req := &Request{Method: method, Path: endpoint}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON request: %v", err)
+		}
+		req.Body = data
+		req.Headers = map[string]string{"Content-Type": "application/json"}
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Body, result); err != nil {
+		return fmt.Errorf("failed to parse JSON response: %v", err)
+	}
+
+	return nil
+}
@@ -1,9 +1,15 @@
 package runnergroup
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -76,3 +82,75 @@ func TestJSONUnmarshaling(t *testing.T) {
 	}
 }
 
+func TestClient_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// Cancel while the first page is being served; no further pages should be requested
+		cancel()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", `<`+"http://"+r.Host+r.URL.Path+`?per_page=100&page=2>; rel="next"`)
+		_, _ = w.Write([]byte(`{"total_count":2,"runners":[{"id":1,"name":"runner-1"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient().WithTransport(&HTTPTransport{BaseURL: server.URL})
+	_, err := client.GetOrgRunnersContext(ctx, "test-org", "1")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestClient_ContextCancelsRetryWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewClient().WithTransport(&HTTPTransport{BaseURL: server.URL})
+
+	start := time.Now()
+	_, err := client.ListRunnerGroupsContext(ctx, "test-enterprise")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected retry wait to be interrupted, took %v", elapsed)
+	}
+}
+
+func TestClient_ConcurrentRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An exhausted rate limit that has already reset makes every response update the client
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"Default"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient().WithTransport(&HTTPTransport{BaseURL: server.URL})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGroup(context.Background(), OrganizationScope("test-org"), 1); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,158 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Runner group visibilities
+const (
+	// VisibilityAll lets every repository (or organization, for enterprise groups) use the group
+	VisibilityAll = "all"
+	// VisibilitySelected limits the group to selected repositories or organizations
+	VisibilitySelected = "selected"
+	// VisibilityPrivate limits an organization group to private repositories
+	VisibilityPrivate = "private"
+)
+
+// CreateGroupRequest holds the settings of a runner group to create
+type CreateGroupRequest struct {
+	Name                     string   `json:"name"`
+	Visibility               string   `json:"visibility,omitempty"`
+	SelectedRepositoryIDs    []int    `json:"selected_repository_ids,omitempty"`
+	SelectedOrganizationIDs  []int    `json:"selected_organization_ids,omitempty"`
+	AllowsPublicRepositories bool     `json:"allows_public_repositories"`
+	RestrictedToWorkflows    bool     `json:"restricted_to_workflows"`
+	SelectedWorkflows        []string `json:"selected_workflows,omitempty"`
+}
+
+// CreateGroup creates a runner group in an enterprise or organization
+func (c *Client) CreateGroup(ctx context.Context, scope Scope, req CreateGroupRequest) (*RunnerGroup, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
+	if err := req.validate(scope); err != nil {
+		return nil, err
+	}
+
+	var group RunnerGroup
+	endpoint := fmt.Sprintf("%s/actions/runner-groups", scope.Path())
+	if err := c.SendJSON(ctx, http.MethodPost, endpoint, req, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// validate checks the request against the settings the scope supports
+func (r CreateGroupRequest) validate(scope Scope) error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("runner group name must not be empty")
+	}
+	if err := ValidateVisibility(scope, r.Visibility); err != nil {
+		return err
+	}
+
+	hasSelection := len(r.SelectedRepositoryIDs) > 0 || len(r.SelectedOrganizationIDs) > 0
+	if hasSelection && r.Visibility != VisibilitySelected {
+		return fmt.Errorf("selected repositories or organizations require visibility %q", VisibilitySelected)
+	}
+	if len(r.SelectedRepositoryIDs) > 0 && scope.Kind != ScopeOrganization {
+		return fmt.Errorf("selected repositories are only available for organization runner groups")
+	}
+	if len(r.SelectedOrganizationIDs) > 0 && scope.Kind != ScopeEnterprise {
+		return fmt.Errorf("selected organizations are only available for enterprise runner groups")
+	}
+	if len(r.SelectedWorkflows) > 0 && !r.RestrictedToWorkflows {
+		return fmt.Errorf("selected workflows require the group to be restricted to workflows")
+	}
+	return nil
+}
+
+// Visibilities returns the runner group visibilities available at a scope
+func Visibilities(scope Scope) []string {
+	if scope.Kind == ScopeEnterprise {
+		return []string{VisibilityAll, VisibilitySelected}
+	}
+	return []string{VisibilityAll, VisibilitySelected, VisibilityPrivate}
+}
+
+// ValidateVisibility reports an error for a visibility the scope does not support.
+// An empty visibility leaves the API default in place.
+func ValidateVisibility(scope Scope, visibility string) error {
+	if visibility == "" {
+		return nil
+	}
+	for _, v := range Visibilities(scope) {
+		if visibility == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid visibility %q for %s (valid: %s)", visibility, scope, strings.Join(Visibilities(scope), ", "))
+}
+
+// GetRepositoryID looks up the ID of an "owner/repo" repository
+func (c *Client) GetRepositoryID(ctx context.Context, fullName string) (int, error) {
+	repo, err := ParseRepositoryScope(fullName)
+	if err != nil {
+		return 0, err
+	}
+
+	var result Repository
+	if err := c.CallAPIWithJSONContext(ctx, repo.Path(), &result); err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
+
+// GetOrganizationID looks up the ID of an organization
+func (c *Client) GetOrganizationID(ctx context.Context, login string) (int, error) {
+	var result Organization
+	if err := c.CallAPIWithJSONContext(ctx, OrganizationScope(login).Path(), &result); err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
+
+// ResolveRepositoryIDs returns the IDs of repositories given by numeric ID, by
+// "owner/repo", or by name within the organization of the scope
+func (c *Client) ResolveRepositoryIDs(ctx context.Context, scope Scope, refs []string) ([]int, error) {
+	ids := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		if id, err := strconv.Atoi(ref); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+
+		fullName := ref
+		if !strings.Contains(ref, "/") && scope.Kind == ScopeOrganization {
+			fullName = scope.Name + "/" + ref
+		}
+		id, err := c.GetRepositoryID(ctx, fullName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up repository %s: %w", fullName, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ResolveOrganizationIDs returns the IDs of organizations given by numeric ID or by login
+func (c *Client) ResolveOrganizationIDs(ctx context.Context, refs []string) ([]int, error) {
+	ids := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		if id, err := strconv.Atoi(ref); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+
+		id, err := c.GetOrganizationID(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up organization %s: %w", ref, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,150 @@
+package runnergroup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCreateGroup(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups": jsonResponse(`{"id":7,"name":"gpu","visibility":"selected"}`),
+	}}
+
+	req := CreateGroupRequest{
+		Name:                  "gpu",
+		Visibility:            VisibilitySelected,
+		SelectedRepositoryIDs: []int{9},
+		RestrictedToWorkflows: true,
+		SelectedWorkflows:     []string{"test-org/app/.github/workflows/deploy.yml@main"},
+	}
+	group, err := NewClient().WithTransport(fake).CreateGroup(context.Background(), OrganizationScope("test-org"), req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if group.ID != 7 || group.Name != "gpu" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+
+	sent := fake.requests[0]
+	if sent.Method != http.MethodPost {
+		t.Errorf("Expected POST, got %s", sent.Method)
+	}
+	if sent.Headers["Content-Type"] != "application/json" {
+		t.Errorf("Expected JSON content type, got %q", sent.Headers["Content-Type"])
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(sent.Body, &body); err != nil {
+		t.Fatalf("Expected JSON body, got %q", sent.Body)
+	}
+	expected := map[string]interface{}{
+		"name":                       "gpu",
+		"visibility":                 "selected",
+		"selected_repository_ids":    []interface{}{float64(9)},
+		"allows_public_repositories": false,
+		"restricted_to_workflows":    true,
+		"selected_workflows":         []interface{}{"test-org/app/.github/workflows/deploy.yml@main"},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected body %v, got %v", expected, body)
+	}
+}
+
+func TestCreateGroupRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		scope       Scope
+		req         CreateGroupRequest
+		expectError string
+	}{
+		{
+			name:  "minimal organization group",
+			scope: OrganizationScope("test-org"),
+			req:   CreateGroupRequest{Name: "gpu"},
+		},
+		{
+			name:        "empty name",
+			scope:       OrganizationScope("test-org"),
+			req:         CreateGroupRequest{Name: " "},
+			expectError: "name must not be empty",
+		},
+		{
+			name:        "private enterprise group",
+			scope:       EnterpriseScope("test-enterprise"),
+			req:         CreateGroupRequest{Name: "gpu", Visibility: VisibilityPrivate},
+			expectError: `invalid visibility "private" for enterprise test-enterprise (valid: all, selected)`,
+		},
+		{
+			name:        "selected repositories without selected visibility",
+			scope:       OrganizationScope("test-org"),
+			req:         CreateGroupRequest{Name: "gpu", Visibility: VisibilityAll, SelectedRepositoryIDs: []int{1}},
+			expectError: `require visibility "selected"`,
+		},
+		{
+			name:        "selected organizations for an organization group",
+			scope:       OrganizationScope("test-org"),
+			req:         CreateGroupRequest{Name: "gpu", Visibility: VisibilitySelected, SelectedOrganizationIDs: []int{1}},
+			expectError: "only available for enterprise runner groups",
+		},
+		{
+			name:        "selected workflows without restriction",
+			scope:       OrganizationScope("test-org"),
+			req:         CreateGroupRequest{Name: "gpu", SelectedWorkflows: []string{"a/b/.github/workflows/c.yml@main"}},
+			expectError: "require the group to be restricted to workflows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate(tt.scope)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestResolveRepositoryIDs(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/repos/test-org/app":  jsonResponse(`{"id":11,"name":"app","full_name":"test-org/app"}`),
+		"/repos/other/library": jsonResponse(`{"id":12,"name":"library","full_name":"other/library"}`),
+	}}
+	client := NewClient().WithTransport(fake)
+
+	ids, err := client.ResolveRepositoryIDs(context.Background(), OrganizationScope("test-org"), []string{"app", "other/library", "13"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{11, 12, 13}) {
+		t.Errorf("Expected IDs [11 12 13], got %v", ids)
+	}
+
+	_, err = client.ResolveRepositoryIDs(context.Background(), OrganizationScope("test-org"), []string{"missing"})
+	if err == nil || !strings.Contains(err.Error(), "failed to look up repository test-org/missing") {
+		t.Errorf("Expected lookup error, got %v", err)
+	}
+}
+
+func TestResolveOrganizationIDs(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org": jsonResponse(`{"id":21,"login":"test-org"}`),
+	}}
+
+	ids, err := NewClient().WithTransport(fake).ResolveOrganizationIDs(context.Background(), []string{"test-org", "22"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{21, 22}) {
+		t.Errorf("Expected IDs [21 22], got %v", ids)
+	}
+}
@@ -0,0 +1,29 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DeleteGroup deletes a runner group from an enterprise or organization.
+// GitHub moves the runners of the group to the default group.
+func (c *Client) DeleteGroup(ctx context.Context, scope Scope, groupID int) error {
+	if err := scope.validateGroups(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d", scope.Path(), groupID)
+	return c.SendJSON(ctx, http.MethodDelete, endpoint, nil, nil)
+}
+
+// CheckDeletable reports why a runner group cannot be deleted, if it cannot
+func CheckDeletable(group RunnerGroup) error {
+	switch {
+	case group.Default:
+		return fmt.Errorf("the default runner group %s cannot be deleted", group.Name)
+	case group.Inherited:
+		return fmt.Errorf("runner group %s is inherited from the enterprise; delete it from the enterprise instead", group.Name)
+	}
+	return nil
+}
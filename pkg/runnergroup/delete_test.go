@@ -0,0 +1,52 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDeleteGroup(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups/5": {StatusCode: http.StatusNoContent, Header: http.Header{}},
+	}}
+
+	if err := NewClient().WithTransport(fake).DeleteGroup(context.Background(), OrganizationScope("test-org"), 5); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sent := fake.requests[0]
+	if sent.Method != http.MethodDelete {
+		t.Errorf("Expected DELETE, got %s", sent.Method)
+	}
+	if len(sent.Body) != 0 {
+		t.Errorf("Expected no body, got %q", sent.Body)
+	}
+}
+
+func TestCheckDeletable(t *testing.T) {
+	tests := []struct {
+		name        string
+		group       RunnerGroup
+		expectError string
+	}{
+		{name: "regular group", group: RunnerGroup{Name: "gpu"}},
+		{name: "default group", group: RunnerGroup{Name: "Default", Default: true}, expectError: "the default runner group Default cannot be deleted"},
+		{name: "inherited group", group: RunnerGroup{Name: "shared", Inherited: true}, expectError: "runner group shared is inherited from the enterprise; delete it from the enterprise instead"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDeletable(tt.group)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectError {
+				t.Errorf("Expected error %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package runnergroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// UpdateGroupRequest holds the runner group settings to change. Nil fields are left unchanged.
+type UpdateGroupRequest struct {
+	Name                     *string   `json:"name,omitempty"`
+	Visibility               *string   `json:"visibility,omitempty"`
+	AllowsPublicRepositories *bool     `json:"allows_public_repositories,omitempty"`
+	RestrictedToWorkflows    *bool     `json:"restricted_to_workflows,omitempty"`
+	SelectedWorkflows        *[]string `json:"selected_workflows,omitempty"`
+}
+
+// Empty reports whether the request changes nothing
+func (r UpdateGroupRequest) Empty() bool {
+	return r == UpdateGroupRequest{}
+}
+
+// Apply returns the group as it will be after the update
+func (r UpdateGroupRequest) Apply(group RunnerGroup) RunnerGroup {
+	if r.Name != nil {
+		group.Name = *r.Name
+	}
+	if r.Visibility != nil {
+		group.Visibility = *r.Visibility
+	}
+	if r.AllowsPublicRepositories != nil {
+		group.AllowsPublicRepositories = *r.AllowsPublicRepositories
+	}
+	if r.RestrictedToWorkflows != nil {
+		group.RestrictedToWorkflows = *r.RestrictedToWorkflows
+	}
+	if r.SelectedWorkflows != nil {
+		group.SelectedWorkflows = *r.SelectedWorkflows
+	}
+	return group
+}
+
+// Validate checks the request against the settings the scope supports
+func (r UpdateGroupRequest) Validate(scope Scope) error {
+	if r.Empty() {
+		return fmt.Errorf("no changes to the runner group were requested")
+	}
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return fmt.Errorf("runner group name must not be empty")
+	}
+	if r.Visibility != nil {
+		if err := ValidateVisibility(scope, *r.Visibility); err != nil {
+			return err
+		}
+	}
+	hasWorkflows := r.SelectedWorkflows != nil && len(*r.SelectedWorkflows) > 0
+	if hasWorkflows && r.RestrictedToWorkflows != nil && !*r.RestrictedToWorkflows {
+		return fmt.Errorf("selected workflows require the group to be restricted to workflows")
+	}
+	return nil
+}
+
+// UpdateGroup changes the settings of a runner group in an enterprise or organization
+func (c *Client) UpdateGroup(ctx context.Context, scope Scope, groupID int, req UpdateGroupRequest) (*RunnerGroup, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(scope); err != nil {
+		return nil, err
+	}
+
+	var group RunnerGroup
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d", scope.Path(), groupID)
+	if err := c.SendJSON(ctx, http.MethodPatch, endpoint, req, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// FieldChange describes a runner group field that differs between two versions
+type FieldChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+// DiffGroups returns the JSON fields that differ between before and after, in field order
+func DiffGroups(before, after RunnerGroup) ([]FieldChange, error) {
+	beforeFields, err := ExportFields(before, RunnerGroupFields)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := ExportFields(after, RunnerGroupFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FieldChange
+	for _, field := range RunnerGroupFields {
+		if !reflect.DeepEqual(normalizeEmpty(beforeFields[field]), normalizeEmpty(afterFields[field])) {
+			changes = append(changes, FieldChange{Field: field, Before: beforeFields[field], After: afterFields[field]})
+		}
+	}
+	return changes, nil
+}
+
+// normalizeEmpty treats an empty list like a missing one, as the API does
+func normalizeEmpty(v interface{}) interface{} {
+	if list, ok := v.([]interface{}); ok && len(list) == 0 {
+		return nil
+	}
+	return v
+}
+
+// FormatFieldValue returns an exported field value as shown in a diff
+func FormatFieldValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		return value
+	case []interface{}:
+		if len(value) == 0 {
+			return "(none)"
+		}
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, FormatFieldValue(item))
+		}
+		return strings.Join(items, ", ")
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,117 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateGroup(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/enterprises/test-enterprise/actions/runner-groups/5": jsonResponse(`{"id":5,"name":"linux-x64","visibility":"all"}`),
+	}}
+
+	name := "linux-x64"
+	restricted := false
+	req := UpdateGroupRequest{Name: &name, RestrictedToWorkflows: &restricted}
+
+	group, err := NewClient().WithTransport(fake).UpdateGroup(context.Background(), EnterpriseScope("test-enterprise"), 5, req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if group.Name != "linux-x64" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+
+	sent := fake.requests[0]
+	if sent.Method != http.MethodPatch {
+		t.Errorf("Expected PATCH, got %s", sent.Method)
+	}
+	// Only the given fields are sent, including false values
+	if expected := `{"name":"linux-x64","restricted_to_workflows":false}`; string(sent.Body) != expected {
+		t.Errorf("Expected body %s, got %s", expected, sent.Body)
+	}
+}
+
+func TestUpdateGroup_Validation(t *testing.T) {
+	private := VisibilityPrivate
+	unrestricted := false
+	workflows := []string{"a/b/.github/workflows/c.yml@main"}
+	tests := []struct {
+		name  string
+		scope Scope
+		req   UpdateGroupRequest
+	}{
+		{name: "no changes", scope: OrganizationScope("test-org"), req: UpdateGroupRequest{}},
+		{name: "private enterprise group", scope: EnterpriseScope("test-enterprise"), req: UpdateGroupRequest{Visibility: &private}},
+		{name: "selected workflows without restriction", scope: OrganizationScope("test-org"), req: UpdateGroupRequest{RestrictedToWorkflows: &unrestricted, SelectedWorkflows: &workflows}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeTransport{}
+			if _, err := NewClient().WithTransport(fake).UpdateGroup(context.Background(), tt.scope, 5, tt.req); err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if len(fake.requests) != 0 {
+				t.Errorf("Expected no requests, got %d", len(fake.requests))
+			}
+		})
+	}
+}
+
+func TestDiffGroups(t *testing.T) {
+	before := RunnerGroup{ID: 5, Name: "deploy", Visibility: "all", SelectedWorkflows: []string{}}
+
+	restricted := true
+	workflows := []string{"myorg/app/.github/workflows/deploy.yml@main"}
+	visibility := "all"
+	after := UpdateGroupRequest{
+		Visibility:            &visibility,
+		RestrictedToWorkflows: &restricted,
+		SelectedWorkflows:     &workflows,
+	}.Apply(before)
+
+	changes, err := DiffGroups(before, after)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []FieldChange{
+		{Field: "restricted_to_workflows", Before: false, After: true},
+		{Field: "selected_workflows", Before: []interface{}{}, After: []interface{}{"myorg/app/.github/workflows/deploy.yml@main"}},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected changes %+v, got %+v", expected, changes)
+	}
+
+	// An empty list and a missing one are the same setting
+	unchanged, err := DiffGroups(before, RunnerGroup{ID: 5, Name: "deploy", Visibility: "all"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(unchanged) != 0 {
+		t.Errorf("Expected no changes, got %+v", unchanged)
+	}
+}
+
+func TestFormatFieldValue(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: nil, expected: "(none)"},
+		{value: "selected", expected: "selected"},
+		{value: true, expected: "true"},
+		{value: float64(5), expected: "5"},
+		{value: []interface{}{}, expected: "(none)"},
+		{value: []interface{}{"a", "b"}, expected: "a, b"},
+	}
+
+	for _, tt := range tests {
+		if result := FormatFieldValue(tt.value); result != tt.expected {
+			t.Errorf("FormatFieldValue(%v) = %q, expected %q", tt.value, result, tt.expected)
+		}
+	}
+}
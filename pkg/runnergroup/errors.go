@@ -0,0 +1,130 @@
+package runnergroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError represents an unsuccessful response from the GitHub API
+type APIError struct {
+	StatusCode       int
+	Path             string
+	Message          string
+	DocumentationURL string
+	// AcceptedScopes lists the OAuth scopes the endpoint accepts (X-Accepted-OAuth-Scopes)
+	AcceptedScopes []string
+	// TokenScopes lists the OAuth scopes granted to the token (X-OAuth-Scopes)
+	TokenScopes []string
+}
+
+// Error returns the status code and GitHub message
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("HTTP %d", e.StatusCode)
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.Path != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, e.Path)
+	}
+	return msg
+}
+
+// MissingScopes returns the accepted OAuth scopes when the token has none of them.
+// It returns nil when the token scopes are unknown (e.g. fine-grained tokens).
+func (e *APIError) MissingScopes() []string {
+	if len(e.TokenScopes) == 0 {
+		return nil
+	}
+	for _, accepted := range e.AcceptedScopes {
+		for _, granted := range e.TokenScopes {
+			if accepted == granted {
+				return nil
+			}
+		}
+	}
+	return e.AcceptedScopes
+}
+
+// NotFoundError is returned for 404 responses
+type NotFoundError struct{ *APIError }
+
+// Unwrap returns the underlying APIError
+func (e *NotFoundError) Unwrap() error { return e.APIError }
+
+// UnauthorizedError is returned for 401 responses
+type UnauthorizedError struct{ *APIError }
+
+// Unwrap returns the underlying APIError
+func (e *UnauthorizedError) Unwrap() error { return e.APIError }
+
+// ForbiddenError is returned for 403 responses that are not caused by rate limiting
+type ForbiddenError struct{ *APIError }
+
+// Unwrap returns the underlying APIError
+func (e *ForbiddenError) Unwrap() error { return e.APIError }
+
+// RateLimitedError is returned when a primary or secondary rate limit is exceeded
+type RateLimitedError struct {
+	*APIError
+	// ResetAt is when the primary rate limit resets, if known
+	ResetAt time.Time
+	// RetryAfter is the wait requested by the server, if any
+	RetryAfter time.Duration
+}
+
+// Unwrap returns the underlying APIError
+func (e *RateLimitedError) Unwrap() error { return e.APIError }
+
+// newAPIError builds the typed error matching an unsuccessful response
+func newAPIError(resp *Response, path string) error {
+	apiErr := &APIError{
+		StatusCode:     resp.StatusCode,
+		Path:           path,
+		AcceptedScopes: splitScopes(resp.Header.Get("X-Accepted-OAuth-Scopes")),
+		TokenScopes:    splitScopes(resp.Header.Get("X-OAuth-Scopes")),
+	}
+
+	var body struct {
+		Message          string `json:"message"`
+		DocumentationURL string `json:"documentation_url"`
+	}
+	if err := json.Unmarshal(resp.Body, &body); err == nil {
+		apiErr.Message = body.Message
+		apiErr.DocumentationURL = body.DocumentationURL
+	} else {
+		apiErr.Message = strings.TrimSpace(string(resp.Body))
+	}
+
+	switch {
+	case isRateLimited(resp):
+		rateErr := &RateLimitedError{APIError: apiErr}
+		if reset, ok := rateLimitReset(resp.Header); ok {
+			rateErr.ResetAt = reset
+		}
+		if delay, ok := retryAfter(resp.Header); ok {
+			rateErr.RetryAfter = delay
+		}
+		return rateErr
+	case resp.StatusCode == http.StatusUnauthorized:
+		return &UnauthorizedError{APIError: apiErr}
+	case resp.StatusCode == http.StatusForbidden:
+		return &ForbiddenError{APIError: apiErr}
+	case resp.StatusCode == http.StatusNotFound:
+		return &NotFoundError{APIError: apiErr}
+	}
+	return apiErr
+}
+
+// splitScopes parses a comma-separated OAuth scope header
+func splitScopes(header string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(header, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,130 @@
+package runnergroup
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func errorResponseWith(status int, header map[string]string, body string) *Response {
+	resp := &Response{StatusCode: status, Header: http.Header{}, Body: []byte(body)}
+	for key, value := range header {
+		resp.Header.Set(key, value)
+	}
+	return resp
+}
+
+func TestNewAPIError_Types(t *testing.T) {
+	tests := []struct {
+		name  string
+		resp  *Response
+		check func(err error) bool
+	}{
+		{
+			name: "not found",
+			resp: errorResponseWith(http.StatusNotFound, nil, `{"message":"Not Found"}`),
+			check: func(err error) bool {
+				var target *NotFoundError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name: "unauthorized",
+			resp: errorResponseWith(http.StatusUnauthorized, nil, `{"message":"Bad credentials"}`),
+			check: func(err error) bool {
+				var target *UnauthorizedError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name: "forbidden",
+			resp: errorResponseWith(http.StatusForbidden, nil, `{"message":"Must have admin rights to Repository."}`),
+			check: func(err error) bool {
+				var target *ForbiddenError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name: "secondary rate limit",
+			resp: errorResponseWith(http.StatusForbidden, map[string]string{"Retry-After": "60"}, `{"message":"You have exceeded a secondary rate limit."}`),
+			check: func(err error) bool {
+				var target *RateLimitedError
+				return errors.As(err, &target) && target.RetryAfter == time.Minute
+			},
+		},
+		{
+			name: "primary rate limit",
+			resp: errorResponseWith(http.StatusTooManyRequests, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000000"}, `{"message":"API rate limit exceeded"}`),
+			check: func(err error) bool {
+				var target *RateLimitedError
+				return errors.As(err, &target) && target.ResetAt.Equal(time.Unix(1700000000, 0))
+			},
+		},
+		{
+			name: "other status",
+			resp: errorResponseWith(http.StatusUnprocessableEntity, nil, `{"message":"Validation Failed"}`),
+			check: func(err error) bool {
+				var target *APIError
+				return errors.As(err, &target) && target.StatusCode == http.StatusUnprocessableEntity
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newAPIError(tt.resp, "/orgs/test-org/actions/runner-groups")
+			if !tt.check(err) {
+				t.Errorf("Unexpected error type %T: %v", err, err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("Expected error to unwrap to *APIError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestNewAPIError_Fields(t *testing.T) {
+	resp := errorResponseWith(http.StatusNotFound, map[string]string{
+		"X-Accepted-OAuth-Scopes": "admin:enterprise, manage_runners:enterprise",
+		"X-OAuth-Scopes":          "repo, read:org",
+	}, `{"message":"Not Found","documentation_url":"https://docs.github.com/rest/actions/self-hosted-runner-groups"}`)
+
+	err := newAPIError(resp, "/enterprises/test/actions/runner-groups")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+
+	if apiErr.Message != "Not Found" {
+		t.Errorf("Expected message 'Not Found', got %q", apiErr.Message)
+	}
+	if apiErr.DocumentationURL != "https://docs.github.com/rest/actions/self-hosted-runner-groups" {
+		t.Errorf("Unexpected documentation URL %q", apiErr.DocumentationURL)
+	}
+
+	expectedMissing := []string{"admin:enterprise", "manage_runners:enterprise"}
+	if !reflect.DeepEqual(apiErr.MissingScopes(), expectedMissing) {
+		t.Errorf("Expected missing scopes %v, got %v", expectedMissing, apiErr.MissingScopes())
+	}
+
+	expectedError := "HTTP 404: Not Found (/enterprises/test/actions/runner-groups)"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error %q, got %q", expectedError, err.Error())
+	}
+}
+
+func TestAPIError_MissingScopesGranted(t *testing.T) {
+	apiErr := &APIError{
+		AcceptedScopes: []string{"admin:org", "manage_runners:org"},
+		TokenScopes:    []string{"repo", "admin:org"},
+	}
+
+	if missing := apiErr.MissingScopes(); missing != nil {
+		t.Errorf("Expected no missing scopes, got %v", missing)
+	}
+}
@@ -0,0 +1,118 @@
+package runnergroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// RunnerFields lists the fields available when exporting runners as JSON
+var RunnerFields = jsonFields(Runner{})
+
+// RunnerGroupFields lists the fields available when exporting runner groups as JSON
+var RunnerGroupFields = jsonFields(RunnerGroup{})
+
+// RunnerGroupDetailsFields lists the fields available when exporting runner group details as JSON
+var RunnerGroupDetailsFields = jsonFields(RunnerGroupDetails{})
+
+// RepositoryFields lists the fields available when exporting repositories as JSON
+var RepositoryFields = jsonFields(Repository{})
+
+// OrganizationFields lists the fields available when exporting organizations as JSON
+var OrganizationFields = jsonFields(Organization{})
+
+// RunnerTokenFields lists the fields available when exporting runner tokens as JSON
+var RunnerTokenFields = jsonFields(RunnerToken{})
+
+// JITConfigFields lists the fields available when exporting JIT runner configurations as JSON
+var JITConfigFields = jsonFields(JITConfig{})
+
+// jsonFields returns the JSON field names of a struct in declaration order,
+// including the fields of embedded structs as encoding/json flattens them
+func jsonFields(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous && name == "" {
+			fields = append(fields, jsonFields(reflect.Zero(field.Type).Interface())...)
+			continue
+		}
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// ValidateFields reports an error naming the first field not in available
+func ValidateFields(fields, available []string) error {
+	for _, field := range fields {
+		found := false
+		for _, name := range available {
+			if field == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown JSON field: %q\nAvailable fields:\n  %s", field, strings.Join(available, "\n  "))
+		}
+	}
+	return nil
+}
+
+// ExportFields returns the selected fields of v keyed by JSON field name.
+// Fields omitted from the encoded value are exported as null.
+func ExportFields(v interface{}, fields []string) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON: %v", err)
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %v", err)
+	}
+
+	exported := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		exported[field] = all[field]
+	}
+	return exported, nil
+}
+
+// ExportRunners returns the selected fields of each runner
+func ExportRunners(runners []Runner, fields []string) ([]map[string]interface{}, error) {
+	return exportAll(runners, fields)
+}
+
+// ExportRunnerGroups returns the selected fields of each runner group
+func ExportRunnerGroups(groups []RunnerGroup, fields []string) ([]map[string]interface{}, error) {
+	return exportAll(groups, fields)
+}
+
+// ExportRepositories returns the selected fields of each repository
+func ExportRepositories(repos []Repository, fields []string) ([]map[string]interface{}, error) {
+	return exportAll(repos, fields)
+}
+
+// ExportOrganizations returns the selected fields of each organization
+func ExportOrganizations(orgs []Organization, fields []string) ([]map[string]interface{}, error) {
+	return exportAll(orgs, fields)
+}
+
+// exportAll returns the selected fields of each item
+func exportAll[T any](items []T, fields []string) ([]map[string]interface{}, error) {
+	exported := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		data, err := ExportFields(item, fields)
+		if err != nil {
+			return nil, err
+		}
+		exported = append(exported, data)
+	}
+	return exported, nil
+}
@@ -0,0 +1,77 @@
+package runnergroup
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExportFieldLists(t *testing.T) {
+	expectedRunnerFields := []string{"id", "name", "os", "status", "busy", "ephemeral", "runner_group_id", "labels"}
+	if !reflect.DeepEqual(RunnerFields, expectedRunnerFields) {
+		t.Errorf("Expected runner fields %v, got %v", expectedRunnerFields, RunnerFields)
+	}
+
+	for _, field := range []string{"id", "name", "visibility", "default", "restricted_to_workflows", "selected_workflows"} {
+		if err := ValidateFields([]string{field}, RunnerGroupFields); err != nil {
+			t.Errorf("Expected runner group field %q to be available: %v", field, err)
+		}
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	if err := ValidateFields([]string{"id", "name"}, RunnerFields); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	err := ValidateFields([]string{"id", "hostname"}, RunnerFields)
+	if err == nil {
+		t.Fatal("Expected error for unknown field, got nil")
+	}
+	if !strings.Contains(err.Error(), `"hostname"`) || !strings.Contains(err.Error(), "runner_group_id") {
+		t.Errorf("Expected error to name the field and list available fields, got %q", err.Error())
+	}
+}
+
+func TestExportRunners(t *testing.T) {
+	runners := []Runner{
+		{ID: 1, Name: "runner-1", Status: "online", Busy: true, Labels: []Label{{ID: 2, Name: "gpu", Type: LabelTypeCustom}}},
+		{ID: 3, Name: "runner-2", Status: "offline"},
+	}
+
+	exported, err := ExportRunners(runners, []string{"name", "busy", "labels", "runner_group_id"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(exported) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(exported))
+	}
+
+	first := exported[0]
+	if len(first) != 4 {
+		t.Errorf("Expected only the selected fields, got %v", first)
+	}
+	if first["name"] != "runner-1" || first["busy"] != true {
+		t.Errorf("Unexpected exported values %v", first)
+	}
+	if labels, ok := first["labels"].([]interface{}); !ok || len(labels) != 1 {
+		t.Errorf("Expected labels to be exported, got %v", first["labels"])
+	}
+	if value, ok := first["runner_group_id"]; !ok || value != nil {
+		t.Errorf("Expected omitted runner_group_id to be exported as null, got %v", value)
+	}
+}
+
+func TestExportRunnerGroups(t *testing.T) {
+	groups := []RunnerGroup{{ID: 1, Name: "Default", Visibility: "all", Default: true}}
+
+	exported, err := ExportRunnerGroups(groups, []string{"id", "default"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if exported[0]["id"] != float64(1) || exported[0]["default"] != true {
+		t.Errorf("Unexpected exported values %v", exported[0])
+	}
+}
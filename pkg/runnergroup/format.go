@@ -0,0 +1,366 @@
+package runnergroup
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFormat is the output format used when none is specified
+const DefaultFormat = "table"
+
+// Cell is a single value in a table. Color marks a status value; the table
+// format shows it with a colored bullet, other formats only use Text.
+type Cell struct {
+	Text  string
+	Color string
+}
+
+// Table is tabular output that a Formatter renders
+type Table struct {
+	Header []string
+	Rows   [][]Cell
+}
+
+// FormatOptions controls how a Formatter renders a table
+type FormatOptions struct {
+	// Color enables ANSI colors in formats that support them
+	Color bool
+	// TTY renders the table format for a terminal. Otherwise the table
+	// format prints plain tab-separated rows without a header, like gh.
+	TTY bool
+	// Width is the terminal width the table format truncates to; zero means no limit
+	Width int
+}
+
+// Formatter renders a table in a specific output format
+type Formatter interface {
+	Format(w io.Writer, table *Table, opts FormatOptions) error
+}
+
+// FormatterFunc adapts an ordinary function to the Formatter interface
+type FormatterFunc func(w io.Writer, table *Table, opts FormatOptions) error
+
+// Format calls f(w, table, opts)
+func (f FormatterFunc) Format(w io.Writer, table *Table, opts FormatOptions) error {
+	return f(w, table, opts)
+}
+
+// formatters holds the registered formatters by name
+var formatters = map[string]Formatter{}
+
+func init() {
+	RegisterFormatter("table", FormatterFunc(formatTable))
+	RegisterFormatter("csv", FormatterFunc(formatCSV))
+	RegisterFormatter("tsv", FormatterFunc(formatTSV))
+	RegisterFormatter("yaml", FormatterFunc(formatYAML))
+	RegisterFormatter("markdown", FormatterFunc(formatMarkdown))
+}
+
+// RegisterFormatter makes a formatter available under name, replacing any
+// formatter already registered with that name. It is meant to be called from init functions.
+func RegisterFormatter(name string, f Formatter) {
+	formatters[name] = f
+}
+
+// LookupFormatter returns the formatter registered under name
+func LookupFormatter(name string) (Formatter, error) {
+	f, ok := formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown format: %q (valid formats: %s)", name, strings.Join(FormatterNames(), ", "))
+	}
+	return f, nil
+}
+
+// FormatterNames returns the names of the registered formatters in sorted order
+func FormatterNames() []string {
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// display returns the text shown for a cell in the table format on a terminal
+func (c Cell) display() string {
+	if c.Color == "" {
+		return c.Text
+	}
+	return "● " + c.Text
+}
+
+// colorize wraps s in the cell's color
+func (c Cell) colorize(s string) string {
+	return c.Color + s + ColorReset
+}
+
+// colored returns the cell as shown on a terminal, always in color
+func (c Cell) colored() string {
+	if c.Color == "" {
+		return c.Text
+	}
+	return c.colorize(c.display())
+}
+
+// formatTable renders the table through the go-gh table printer, which aligns
+// columns by display width and truncates them to fit the terminal
+func formatTable(w io.Writer, table *Table, opts FormatOptions) error {
+	width := opts.Width
+	if width <= 0 {
+		width = math.MaxInt32
+	}
+	tp := tableprinter.New(w, opts.TTY, width)
+
+	tp.AddHeader(table.Header)
+	for _, row := range table.Rows {
+		for _, cell := range row {
+			if !opts.TTY {
+				tp.AddField(cell.Text)
+				continue
+			}
+
+			// Keep status values whole and colored
+			switch {
+			case cell.Color == "":
+				tp.AddField(cell.Text)
+			case opts.Color:
+				tp.AddField(cell.display(), tableprinter.WithTruncate(nil), tableprinter.WithColor(cell.colorize))
+			default:
+				tp.AddField(cell.display(), tableprinter.WithTruncate(nil))
+			}
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// formatCSV renders the table as RFC 4180 CSV with a header row
+func formatCSV(w io.Writer, table *Table, opts FormatOptions) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(table.Header); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if err := cw.Write(cellTexts(row)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// tsvReplacer replaces characters that would break a TSV row
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+
+// formatTSV renders the table as tab-separated values with a header row
+func formatTSV(w io.Writer, table *Table, opts FormatOptions) error {
+	writeRow := func(texts []string) error {
+		for i, text := range texts {
+			texts[i] = tsvReplacer.Replace(text)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(texts, "\t"))
+		return err
+	}
+
+	if err := writeRow(append([]string(nil), table.Header...)); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if err := writeRow(cellTexts(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatYAML renders the table as a YAML sequence of mappings keyed by
+// the lower-cased column names, keeping the column order
+func formatYAML(w io.Writer, table *Table, opts FormatOptions) error {
+	keys := make([]string, len(table.Header))
+	for i, name := range table.Header {
+		keys[i] = strings.ReplaceAll(strings.ToLower(name), " ", "_")
+	}
+
+	doc := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for _, row := range table.Rows {
+		item := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for i, cell := range row {
+			item.Content = append(item.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keys[i]},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: cell.Text},
+			)
+		}
+		doc.Content = append(doc.Content, item)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode YAML: %v", err)
+	}
+	return enc.Close()
+}
+
+// markdownReplacer escapes characters that would break a Markdown table cell
+var markdownReplacer = strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ")
+
+// formatMarkdown renders the table as a GitHub Flavored Markdown table
+func formatMarkdown(w io.Writer, table *Table, opts FormatOptions) error {
+	writeRow := func(texts []string) error {
+		for i, text := range texts {
+			texts[i] = markdownReplacer.Replace(text)
+		}
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(texts, " | "))
+		return err
+	}
+
+	if err := writeRow(append([]string(nil), table.Header...)); err != nil {
+		return err
+	}
+	separator := make([]string, len(table.Header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	if err := writeRow(separator); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if err := writeRow(cellTexts(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cellTexts returns the text of each cell in a row
+func cellTexts(row []Cell) []string {
+	texts := make([]string, len(row))
+	for i, cell := range row {
+		texts[i] = cell.Text
+	}
+	return texts
+}
+
+// RunnerGroupsTable builds the table shown by the list command
+func RunnerGroupsTable(groups []RunnerGroup) *Table {
+	table := &Table{Header: []string{"ID", "Name", "Visibility"}}
+	for _, group := range groups {
+		table.Rows = append(table.Rows, []Cell{
+			{Text: fmt.Sprint(group.ID)},
+			{Text: group.Name},
+			visibilityCell(group),
+		})
+	}
+	return table
+}
+
+// RunnersTable builds the table shown by the runners command. The wide
+// table adds the OS, ephemeral flag and labels of each runner.
+func RunnersTable(runners []Runner, wide bool) *Table {
+	table := &Table{Header: []string{"Name", "Status"}}
+	if wide {
+		table.Header = append(table.Header, "OS", "Ephemeral", "Labels")
+	}
+
+	for _, runner := range runners {
+		row := []Cell{{Text: runner.Name}, statusCell(runner)}
+		if wide {
+			ephemeral := "no"
+			if runner.Ephemeral {
+				ephemeral = "yes"
+			}
+			row = append(row,
+				Cell{Text: runner.OS},
+				Cell{Text: ephemeral},
+				Cell{Text: strings.Join(LabelNames(runner), ",")},
+			)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// RunnerLabelsTable builds the table shown by the labels list command, with
+// the custom and read-only labels of each runner
+func RunnerLabelsTable(runners []Runner) *Table {
+	table := &Table{Header: []string{"Name", "Custom labels", "Default labels"}}
+	for _, runner := range runners {
+		var custom, readOnly []string
+		for _, label := range runner.Labels {
+			if label.ReadOnly() {
+				readOnly = append(readOnly, label.Name)
+			} else {
+				custom = append(custom, label.Name)
+			}
+		}
+		table.Rows = append(table.Rows, []Cell{
+			{Text: runner.Name},
+			{Text: strings.Join(custom, ",")},
+			{Text: strings.Join(readOnly, ",")},
+		})
+	}
+	return table
+}
+
+// RepositoriesTable builds the table of repositories that can use a runner group
+func RepositoriesTable(repos []Repository) *Table {
+	table := &Table{Header: []string{"ID", "Name", "Visibility"}}
+	for _, repo := range repos {
+		visibility := "public"
+		if repo.Private {
+			visibility = "private"
+		}
+		table.Rows = append(table.Rows, []Cell{
+			{Text: fmt.Sprint(repo.ID)},
+			{Text: repo.FullName},
+			{Text: visibility},
+		})
+	}
+	return table
+}
+
+// OrganizationsTable builds the table of organizations that can use a runner group
+func OrganizationsTable(orgs []Organization) *Table {
+	table := &Table{Header: []string{"ID", "Login"}}
+	for _, org := range orgs {
+		table.Rows = append(table.Rows, []Cell{
+			{Text: fmt.Sprint(org.ID)},
+			{Text: org.Login},
+		})
+	}
+	return table
+}
+
+// statusCell returns the colored status of a runner
+func statusCell(runner Runner) Cell {
+	switch GetRunnerStatus(runner) {
+	case "active":
+		return Cell{Text: "Active", Color: ColorOrange}
+	case "idle":
+		return Cell{Text: "Idle", Color: ColorGreen}
+	default:
+		return Cell{Text: "Offline", Color: ColorGray}
+	}
+}
+
+// visibilityCell returns the colored visibility of a runner group
+func visibilityCell(group RunnerGroup) Cell {
+	switch {
+	case group.Default:
+		// Default group (green)
+		return Cell{Text: group.Visibility + " (default)", Color: ColorGreen}
+	case group.Visibility == "private":
+		// Private (gray)
+		return Cell{Text: group.Visibility, Color: ColorGray}
+	default:
+		// Selected/All (orange)
+		return Cell{Text: group.Visibility, Color: ColorOrange}
+	}
+}
@@ -0,0 +1,198 @@
+package runnergroup
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testRunnersTable() *Table {
+	return RunnersTable([]Runner{
+		{Name: "runner-1", OS: "Linux", Status: "online", Busy: true, Labels: []Label{{Name: "self-hosted"}, {Name: "gpu"}}},
+		{Name: "runner|2", OS: "Windows", Status: "offline", Ephemeral: true},
+	}, true)
+}
+
+func TestFormatters(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: "csv",
+			expected: "Name,Status,OS,Ephemeral,Labels\n" +
+				"runner-1,Active,Linux,no,\"self-hosted,gpu\"\n" +
+				"runner|2,Offline,Windows,yes,\n",
+		},
+		{
+			format: "tsv",
+			expected: "Name\tStatus\tOS\tEphemeral\tLabels\n" +
+				"runner-1\tActive\tLinux\tno\tself-hosted,gpu\n" +
+				"runner|2\tOffline\tWindows\tyes\t\n",
+		},
+		{
+			format: "markdown",
+			expected: "| Name | Status | OS | Ephemeral | Labels |\n" +
+				"| --- | --- | --- | --- | --- |\n" +
+				"| runner-1 | Active | Linux | no | self-hosted,gpu |\n" +
+				"| runner\\|2 | Offline | Windows | yes |  |\n",
+		},
+		{
+			format: "yaml",
+			expected: "- name: runner-1\n" +
+				"  status: Active\n" +
+				"  os: Linux\n" +
+				"  ephemeral: no\n" +
+				"  labels: self-hosted,gpu\n" +
+				"- name: runner|2\n" +
+				"  status: Offline\n" +
+				"  os: Windows\n" +
+				"  ephemeral: yes\n" +
+				"  labels: \"\"\n",
+		},
+		{
+			// Piped table output is plain tab-separated text, like gh
+			format: "table",
+			expected: "runner-1\tActive\tLinux\tno\tself-hosted,gpu\n" +
+				"runner|2\tOffline\tWindows\tyes\t\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := LookupFormatter(tt.format)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := formatter.Format(&buf, testRunnersTable(), FormatOptions{}); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("Expected output:\n%s\ngot:\n%s", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestFormatTable_Terminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		table    *Table
+		opts     FormatOptions
+		expected string
+	}{
+		{
+			name:  "aligned without color",
+			table: testRunnersTable(),
+			opts:  FormatOptions{TTY: true},
+			expected: "Name      Status     OS       Ephemeral  Labels\n" +
+				"runner-1  ● Active   Linux    no         self-hosted,gpu\n" +
+				"runner|2  ● Offline  Windows  yes        \n",
+		},
+		{
+			name:  "colored status padded by its visible width",
+			table: RunnersTable([]Runner{{Name: "runner-1", Status: "online", Busy: true}}, false),
+			opts:  FormatOptions{TTY: true, Color: true},
+			expected: "Name      Status\n" +
+				"runner-1  " + ColorOrange + "● Active" + ColorReset + "\n",
+		},
+		{
+			name:  "multibyte names aligned by display width",
+			table: RunnersTable([]Runner{{Name: "本番ランナー", Status: "online"}, {Name: "ci", Status: "offline"}}, false),
+			opts:  FormatOptions{TTY: true},
+			expected: "Name          Status\n" +
+				"本番ランナー  ● Idle\n" +
+				"ci            ● Offline\n",
+		},
+		{
+			name:  "truncated to terminal width but status kept whole",
+			table: RunnersTable([]Runner{{Name: "a-very-long-runner-name", Status: "offline"}}, false),
+			opts:  FormatOptions{TTY: true, Width: 20},
+			expected: "Name       Status\n" +
+				"a-very...  ● Offline\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := formatTable(&buf, tt.table, tt.opts); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("Expected output:\n%q\ngot:\n%q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestLookupFormatter_Unknown(t *testing.T) {
+	_, err := LookupFormatter("xml")
+	if err == nil {
+		t.Fatal("Expected error for unknown format, got nil")
+	}
+	if !strings.Contains(err.Error(), `"xml"`) || !strings.Contains(err.Error(), "csv, markdown, table, tsv, yaml") {
+		t.Errorf("Expected error to name the format and list valid formats, got %q", err.Error())
+	}
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	RegisterFormatter("names", FormatterFunc(func(w io.Writer, table *Table, opts FormatOptions) error {
+		for _, row := range table.Rows {
+			if _, err := io.WriteString(w, row[0].Text+"\n"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	defer delete(formatters, "names")
+
+	formatter, err := LookupFormatter("names")
+	if err != nil {
+		t.Fatalf("Expected registered formatter, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := formatter.Format(&buf, testRunnersTable(), FormatOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != "runner-1\nrunner|2\n" {
+		t.Errorf("Unexpected output %q", buf.String())
+	}
+}
+
+func TestRunnerGroupsTable(t *testing.T) {
+	table := RunnerGroupsTable([]RunnerGroup{
+		{ID: 1, Name: "Default", Visibility: "all", Default: true},
+		{ID: 2, Name: "private-group", Visibility: "private"},
+		{ID: 3, Name: "selected-group", Visibility: "selected"},
+	})
+
+	if !reflect.DeepEqual(table.Header, []string{"ID", "Name", "Visibility"}) {
+		t.Errorf("Unexpected header %v", table.Header)
+	}
+
+	expected := [][]Cell{
+		{{Text: "1"}, {Text: "Default"}, {Text: "all (default)", Color: ColorGreen}},
+		{{Text: "2"}, {Text: "private-group"}, {Text: "private", Color: ColorGray}},
+		{{Text: "3"}, {Text: "selected-group"}, {Text: "selected", Color: ColorOrange}},
+	}
+	if !reflect.DeepEqual(table.Rows, expected) {
+		t.Errorf("Expected rows %v, got %v", expected, table.Rows)
+	}
+}
+
+func TestRunnersTable_Narrow(t *testing.T) {
+	table := RunnersTable([]Runner{{Name: "runner-1", Status: "online"}}, false)
+
+	if !reflect.DeepEqual(table.Header, []string{"Name", "Status"}) {
+		t.Errorf("Unexpected header %v", table.Header)
+	}
+	if len(table.Rows) != 1 || !reflect.DeepEqual(table.Rows[0], []Cell{{Text: "runner-1"}, {Text: "Idle", Color: ColorGreen}}) {
+		t.Errorf("Unexpected rows %v", table.Rows)
+	}
+}
@@ -0,0 +1,141 @@
+package runnergroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetGroup fetches a single runner group of a scope
+func (c *Client) GetGroup(ctx context.Context, scope Scope, groupID int) (*RunnerGroup, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
+
+	var group RunnerGroup
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d", scope.Path(), groupID)
+	if err := c.CallAPIWithJSONContext(ctx, endpoint, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// ResolveGroup fetches the runner group identified by a numeric ID or by its name.
+// A numeric reference that is not a group ID is looked up as a name.
+func (c *Client) ResolveGroup(ctx context.Context, scope Scope, ref string) (*RunnerGroup, error) {
+	if groupID, err := strconv.Atoi(ref); err == nil {
+		group, err := c.GetGroup(ctx, scope, groupID)
+		var notFound *NotFoundError
+		if !errors.As(err, &notFound) {
+			return group, err
+		}
+	}
+
+	groups, err := c.ListGroups(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	group, err := FindGroup(groups, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%v in %s", err, scope)
+	}
+	return group, nil
+}
+
+// FindGroup returns the runner group with the given name. An exact match is
+// preferred; otherwise the name is matched case-insensitively and more than
+// one match is reported as ambiguous.
+func FindGroup(groups []RunnerGroup, name string) (*RunnerGroup, error) {
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+
+	var matches []*RunnerGroup
+	for i := range groups {
+		if strings.EqualFold(groups[i].Name, name) {
+			matches = append(matches, &groups[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("runner group %q not found", name)
+	case 1:
+		return matches[0], nil
+	}
+
+	candidates := make([]string, 0, len(matches))
+	for _, group := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s (ID %d)", group.Name, group.ID))
+	}
+	return nil, fmt.Errorf("runner group name %q is ambiguous; use one of the IDs: %s", name, strings.Join(candidates, ", "))
+}
+
+// GetGroupDetails fetches a runner group together with its runner counts and,
+// for groups with selected visibility, the repositories or organizations it is shared with
+func (c *Client) GetGroupDetails(ctx context.Context, scope Scope, group RunnerGroup) (*RunnerGroupDetails, error) {
+	runners, err := c.ListGroupRunners(ctx, scope, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &RunnerGroupDetails{
+		RunnerGroup:  group,
+		RunnerCounts: CountRunners(runners),
+	}
+
+	// Leave the list that does not apply nil so it is exported as null
+	if group.Visibility == VisibilitySelected {
+		switch scope.Kind {
+		case ScopeOrganization:
+			repos, err := c.ListGroupRepositories(ctx, scope, group.ID)
+			if err != nil {
+				return nil, err
+			}
+			details.SelectedRepositories = append([]Repository{}, repos...)
+		case ScopeEnterprise:
+			orgs, err := c.ListGroupOrganizations(ctx, scope, group.ID)
+			if err != nil {
+				return nil, err
+			}
+			details.SelectedOrganizations = append([]Organization{}, orgs...)
+		}
+	}
+
+	return details, nil
+}
+
+// CheckSelectedVisibility reports an error for a group that is not limited to
+// selected repositories or organizations, as its access list has no effect
+func CheckSelectedVisibility(scope Scope, group RunnerGroup) error {
+	if group.Visibility == VisibilitySelected {
+		return nil
+	}
+
+	flag, selection := "--org", "repositories"
+	if scope.Kind == ScopeEnterprise {
+		flag, selection = "--enterprise", "organizations"
+	}
+	return fmt.Errorf("runner group %s has visibility %s; run `gh runner-groups edit %d %s %s --visibility %s` to share it with selected %s",
+		group.Name, group.Visibility, group.ID, flag, scope.Name, VisibilitySelected, selection)
+}
+
+// CountRunners returns the number of runners in each status
+func CountRunners(runners []Runner) RunnerCounts {
+	counts := RunnerCounts{Total: len(runners)}
+	for _, runner := range runners {
+		switch GetRunnerStatus(runner) {
+		case "active":
+			counts.Active++
+		case "idle":
+			counts.Idle++
+		default:
+			counts.Offline++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,187 @@
+package runnergroup
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveGroup(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups/2":                   jsonResponse(`{"id":2,"name":"gpu"}`),
+		"/orgs/test-org/actions/runner-groups?per_page=100&page=1": jsonResponse(`{"total_count":3,"runner_groups":[{"id":1,"name":"Default"},{"id":2,"name":"gpu"},{"id":3,"name":"2024"}]}`),
+	}}
+	client := NewClient().WithTransport(fake)
+	scope := OrganizationScope("test-org")
+
+	tests := []struct {
+		name        string
+		ref         string
+		expectedID  int
+		expectError string
+	}{
+		{name: "by ID", ref: "2", expectedID: 2},
+		{name: "by name", ref: "gpu", expectedID: 2},
+		{name: "by name case-insensitively", ref: "GPU", expectedID: 2},
+		{name: "numeric name that is not an ID", ref: "2024", expectedID: 3},
+		{name: "unknown name", ref: "arm", expectError: `runner group "arm" not found in organization test-org`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := client.ResolveGroup(context.Background(), scope, tt.ref)
+			if tt.expectError != "" {
+				if err == nil || err.Error() != tt.expectError {
+					t.Errorf("Expected error %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if group.ID != tt.expectedID {
+				t.Errorf("Expected group %d, got %d", tt.expectedID, group.ID)
+			}
+		})
+	}
+}
+
+func TestFindGroup(t *testing.T) {
+	groups := []RunnerGroup{
+		{ID: 1, Name: "Default"},
+		{ID: 2, Name: "linux"},
+		{ID: 3, Name: "Linux"},
+	}
+
+	tests := []struct {
+		name        string
+		ref         string
+		expectedID  int
+		expectError string
+	}{
+		{name: "exact match wins over case-insensitive matches", ref: "Linux", expectedID: 3},
+		{name: "single case-insensitive match", ref: "default", expectedID: 1},
+		{name: "ambiguous case-insensitive matches", ref: "LINUX", expectError: `runner group name "LINUX" is ambiguous; use one of the IDs: linux (ID 2), Linux (ID 3)`},
+		{name: "no match", ref: "arm", expectError: `runner group "arm" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := FindGroup(groups, tt.ref)
+			if tt.expectError != "" {
+				if err == nil || err.Error() != tt.expectError {
+					t.Errorf("Expected error %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if group.ID != tt.expectedID {
+				t.Errorf("Expected group %d, got %d", tt.expectedID, group.ID)
+			}
+		})
+	}
+}
+
+func TestGetGroupDetails(t *testing.T) {
+	runners := `{"total_count":3,"runners":[` +
+		`{"id":1,"name":"a","status":"online","busy":true},` +
+		`{"id":2,"name":"b","status":"online","busy":false},` +
+		`{"id":3,"name":"c","status":"offline","busy":false}]}`
+
+	tests := []struct {
+		name          string
+		scope         Scope
+		group         RunnerGroup
+		responses     map[string]*Response
+		expectedRepos []Repository
+		expectedOrgs  []Organization
+	}{
+		{
+			name:  "organization group with selected repositories",
+			scope: OrganizationScope("test-org"),
+			group: RunnerGroup{ID: 5, Name: "gpu", Visibility: "selected"},
+			responses: map[string]*Response{
+				"/orgs/test-org/actions/runner-groups/5/runners?per_page=100&page=1":      jsonResponse(runners),
+				"/orgs/test-org/actions/runner-groups/5/repositories?per_page=100&page=1": jsonResponse(`{"total_count":1,"repositories":[{"id":9,"name":"app","full_name":"test-org/app"}]}`),
+			},
+			expectedRepos: []Repository{{ID: 9, Name: "app", FullName: "test-org/app"}},
+		},
+		{
+			name:  "enterprise group with no selected organizations",
+			scope: EnterpriseScope("test-enterprise"),
+			group: RunnerGroup{ID: 5, Name: "gpu", Visibility: "selected"},
+			responses: map[string]*Response{
+				"/enterprises/test-enterprise/actions/runner-groups/5/runners?per_page=100&page=1":       jsonResponse(runners),
+				"/enterprises/test-enterprise/actions/runner-groups/5/organizations?per_page=100&page=1": jsonResponse(`{"total_count":0,"organizations":[]}`),
+			},
+			expectedOrgs: []Organization{},
+		},
+		{
+			name:  "group visible to all",
+			scope: OrganizationScope("test-org"),
+			group: RunnerGroup{ID: 5, Name: "gpu", Visibility: "all"},
+			responses: map[string]*Response{
+				"/orgs/test-org/actions/runner-groups/5/runners?per_page=100&page=1": jsonResponse(runners),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeTransport{responses: tt.responses}
+			details, err := NewClient().WithTransport(fake).GetGroupDetails(context.Background(), tt.scope, tt.group)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			expectedCounts := RunnerCounts{Total: 3, Active: 1, Idle: 1, Offline: 1}
+			if details.RunnerCounts != expectedCounts {
+				t.Errorf("Expected counts %+v, got %+v", expectedCounts, details.RunnerCounts)
+			}
+			if !reflect.DeepEqual(details.SelectedRepositories, tt.expectedRepos) {
+				t.Errorf("Expected repositories %+v, got %+v", tt.expectedRepos, details.SelectedRepositories)
+			}
+			if !reflect.DeepEqual(details.SelectedOrganizations, tt.expectedOrgs) {
+				t.Errorf("Expected organizations %+v, got %+v", tt.expectedOrgs, details.SelectedOrganizations)
+			}
+		})
+	}
+}
+
+func TestListGroupRepositories_WrongScope(t *testing.T) {
+	_, err := NewClient().WithTransport(&fakeTransport{}).ListGroupRepositories(context.Background(), EnterpriseScope("test-enterprise"), 1)
+	if err == nil || !strings.Contains(err.Error(), "organization runner groups") {
+		t.Errorf("Expected scope error, got %v", err)
+	}
+}
+
+func TestRunnerGroupDetailsFields(t *testing.T) {
+	for _, field := range []string{"id", "name", "restricted_to_workflows", "runner_counts", "selected_repositories", "selected_organizations"} {
+		if err := ValidateFields([]string{field}, RunnerGroupDetailsFields); err != nil {
+			t.Errorf("Expected field %q to be available: %v", field, err)
+		}
+	}
+
+	exported, err := ExportFields(RunnerGroupDetails{RunnerGroup: RunnerGroup{Name: "gpu"}}, []string{"name", "selected_repositories"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if exported["name"] != "gpu" || exported["selected_repositories"] != nil {
+		t.Errorf("Unexpected exported values %v", exported)
+	}
+}
+
+func TestCheckSelectedVisibility(t *testing.T) {
+	if err := CheckSelectedVisibility(OrganizationScope("test-org"), RunnerGroup{ID: 5, Name: "gpu", Visibility: "selected"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	err := CheckSelectedVisibility(OrganizationScope("test-org"), RunnerGroup{ID: 5, Name: "gpu", Visibility: "all"})
+	expected := "runner group gpu has visibility all; run `gh runner-groups edit 5 --org test-org --visibility selected` to share it with selected repositories"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
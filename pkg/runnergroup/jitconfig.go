@@ -0,0 +1,57 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxJITConfigLabels is the maximum number of labels the API accepts for a JIT runner
+const maxJITConfigLabels = 100
+
+// JITConfigRequest holds the settings of a just-in-time runner
+type JITConfigRequest struct {
+	Name          string   `json:"name"`
+	RunnerGroupID int      `json:"runner_group_id"`
+	Labels        []string `json:"labels"`
+	WorkFolder    string   `json:"work_folder,omitempty"`
+}
+
+// JITConfig is the configuration of a just-in-time runner registered by the API
+type JITConfig struct {
+	Runner           Runner `json:"runner"`
+	EncodedJITConfig string `json:"encoded_jit_config"`
+}
+
+// GenerateJITConfig registers a just-in-time runner in a runner group and returns
+// the encoded configuration to start it with (run.sh --jitconfig)
+func (c *Client) GenerateJITConfig(ctx context.Context, scope Scope, req JITConfigRequest) (*JITConfig, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	var config JITConfig
+	endpoint := fmt.Sprintf("%s/actions/runners/generate-jitconfig", scope.Path())
+	if err := c.SendJSON(ctx, http.MethodPost, endpoint, req, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// validate checks the request against the limits of the API
+func (r JITConfigRequest) validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("runner name must not be empty")
+	}
+	if len(r.Labels) == 0 {
+		return fmt.Errorf("at least one label is required")
+	}
+	if len(r.Labels) > maxJITConfigLabels {
+		return fmt.Errorf("too many labels: %d (at most %d)", len(r.Labels), maxJITConfigLabels)
+	}
+	return validateLabelNames(r.Labels)
+}
@@ -0,0 +1,63 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGenerateJITConfig(t *testing.T) {
+	created := jsonResponse(`{"runner":{"id":23,"name":"ci-1","status":"offline","labels":[{"name":"gpu","type":"custom"}]},"encoded_jit_config":"abc123"}`)
+	created.StatusCode = http.StatusCreated
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runners/generate-jitconfig": created,
+	}}
+
+	config, err := NewClient().WithTransport(fake).GenerateJITConfig(context.Background(), OrganizationScope("test-org"), JITConfigRequest{
+		Name:          "ci-1",
+		RunnerGroupID: 5,
+		Labels:        []string{"gpu"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.EncodedJITConfig != "abc123" || config.Runner.ID != 23 {
+		t.Errorf("Unexpected config %+v", config)
+	}
+
+	sent := fake.requests[0]
+	expected := `{"name":"ci-1","runner_group_id":5,"labels":["gpu"]}`
+	if sent.Method != http.MethodPost || string(sent.Body) != expected {
+		t.Errorf("Expected POST %s, got %s %s", expected, sent.Method, sent.Body)
+	}
+}
+
+func TestJITConfigRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         JITConfigRequest
+		expectError string
+	}{
+		{name: "valid", req: JITConfigRequest{Name: "ci-1", Labels: []string{"gpu"}, WorkFolder: "/tmp/work"}},
+		{name: "missing name", req: JITConfigRequest{Labels: []string{"gpu"}}, expectError: "runner name must not be empty"},
+		{name: "missing labels", req: JITConfigRequest{Name: "ci-1"}, expectError: "at least one label is required"},
+		{name: "too many labels", req: JITConfigRequest{Name: "ci-1", Labels: make([]string, 101)}, expectError: "too many labels: 101 (at most 100)"},
+		{name: "empty label", req: JITConfigRequest{Name: "ci-1", Labels: []string{""}}, expectError: "label names must not be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
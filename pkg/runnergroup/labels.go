@@ -0,0 +1,105 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// LabelsResponse represents the API response containing the labels of a runner
+type LabelsResponse struct {
+	TotalCount int     `json:"total_count"`
+	Labels     []Label `json:"labels"`
+}
+
+// labelsRequest is the body of requests that add or replace custom labels
+type labelsRequest struct {
+	Labels []string `json:"labels"`
+}
+
+// ListRunnerLabels fetches all labels of a runner
+func (c *Client) ListRunnerLabels(ctx context.Context, scope Scope, runnerID int) ([]Label, error) {
+	return c.sendLabels(ctx, scope, http.MethodGet, runnerLabelsPath(scope, runnerID), nil)
+}
+
+// AddRunnerLabels adds custom labels to a runner and returns all of its labels
+func (c *Client) AddRunnerLabels(ctx context.Context, scope Scope, runnerID int, labels []string) ([]Label, error) {
+	if err := validateLabelNames(labels); err != nil {
+		return nil, err
+	}
+	return c.sendLabels(ctx, scope, http.MethodPost, runnerLabelsPath(scope, runnerID), labelsRequest{Labels: labels})
+}
+
+// SetRunnerLabels replaces the custom labels of a runner and returns all of its
+// labels. Read-only labels are kept; an empty list removes every custom label.
+func (c *Client) SetRunnerLabels(ctx context.Context, scope Scope, runnerID int, labels []string) ([]Label, error) {
+	if err := validateLabelNames(labels); err != nil {
+		return nil, err
+	}
+	return c.sendLabels(ctx, scope, http.MethodPut, runnerLabelsPath(scope, runnerID), labelsRequest{Labels: append([]string{}, labels...)})
+}
+
+// RemoveRunnerLabel removes a custom label from a runner and returns its remaining labels
+func (c *Client) RemoveRunnerLabel(ctx context.Context, scope Scope, runnerID int, name string) ([]Label, error) {
+	if err := validateLabelNames([]string{name}); err != nil {
+		return nil, err
+	}
+	endpoint := runnerLabelsPath(scope, runnerID) + "/" + url.PathEscape(name)
+	return c.sendLabels(ctx, scope, http.MethodDelete, endpoint, nil)
+}
+
+// sendLabels makes a labels API call and returns the labels in the response
+func (c *Client) sendLabels(ctx context.Context, scope Scope, method, endpoint string, body interface{}) ([]Label, error) {
+	if err := scope.validate(); err != nil {
+		return nil, err
+	}
+
+	var response LabelsResponse
+	if err := c.SendJSON(ctx, method, endpoint, body, &response); err != nil {
+		return nil, err
+	}
+	return response.Labels, nil
+}
+
+// runnerLabelsPath returns the labels endpoint of a runner
+func runnerLabelsPath(scope Scope, runnerID int) string {
+	return fmt.Sprintf("%s/actions/runners/%d/labels", scope.Path(), runnerID)
+}
+
+// validateLabelNames reports an error for an empty label name
+func validateLabelNames(names []string) error {
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("label names must not be empty")
+		}
+	}
+	return nil
+}
+
+// MatchingLabels returns the labels of the runner that match one of the names,
+// compared case-insensitively as when routing jobs
+func MatchingLabels(runner Runner, names []string) []Label {
+	var labels []Label
+	for _, label := range runner.Labels {
+		for _, name := range names {
+			if strings.EqualFold(label.Name, name) {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+	return labels
+}
+
+// CheckCustomLabels reports an error when one of the named labels is a
+// read-only default label of the runner, which cannot be removed or replaced
+func CheckCustomLabels(runner Runner, names []string) error {
+	for _, label := range MatchingLabels(runner, names) {
+		if label.ReadOnly() {
+			return fmt.Errorf("label %s of runner %s is a read-only default label and cannot be changed", label.Name, runner.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRunnerLabelChanges(t *testing.T) {
+	labels := jsonResponse(`{"total_count":2,"labels":[{"id":1,"name":"self-hosted","type":"read-only"},{"id":2,"name":"gpu","type":"custom"}]}`)
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runners/42/labels":           labels,
+		"/orgs/test-org/actions/runners/42/labels/cuda%2012": labels,
+	}}
+	client := NewClient().WithTransport(fake)
+	scope := OrganizationScope("test-org")
+	ctx := context.Background()
+
+	got, err := client.ListRunnerLabels(ctx, scope, 42)
+	if err != nil {
+		t.Fatalf("Expected no error listing, got %v", err)
+	}
+	if len(got) != 2 || got[1].Name != "gpu" {
+		t.Errorf("Unexpected labels %v", got)
+	}
+	if _, err := client.AddRunnerLabels(ctx, scope, 42, []string{"gpu"}); err != nil {
+		t.Fatalf("Expected no error adding, got %v", err)
+	}
+	if _, err := client.SetRunnerLabels(ctx, scope, 42, nil); err != nil {
+		t.Fatalf("Expected no error setting, got %v", err)
+	}
+	if _, err := client.RemoveRunnerLabel(ctx, scope, 42, "cuda 12"); err != nil {
+		t.Fatalf("Expected no error removing, got %v", err)
+	}
+
+	expected := []struct {
+		method string
+		body   string
+	}{
+		{http.MethodGet, ""},
+		{http.MethodPost, `{"labels":["gpu"]}`},
+		{http.MethodPut, `{"labels":[]}`},
+		{http.MethodDelete, ""},
+	}
+	for i, want := range expected {
+		sent := fake.requests[i]
+		if sent.Method != want.method || string(sent.Body) != want.body {
+			t.Errorf("Request %d: expected %s %q, got %s %q", i, want.method, want.body, sent.Method, sent.Body)
+		}
+	}
+
+	if _, err := client.AddRunnerLabels(ctx, scope, 42, []string{" "}); err == nil {
+		t.Error("Expected an error for an empty label name")
+	}
+}
+
+func TestCheckCustomLabels(t *testing.T) {
+	runner := Runner{Name: "ci-1", Labels: []Label{
+		{Name: "self-hosted", Type: LabelTypeReadOnly},
+		{Name: "Linux", Type: LabelTypeReadOnly},
+		{Name: "gpu", Type: LabelTypeCustom},
+	}}
+
+	if err := CheckCustomLabels(runner, []string{"gpu", "missing"}); err != nil {
+		t.Errorf("Expected no error for custom labels, got %v", err)
+	}
+
+	err := CheckCustomLabels(runner, []string{"gpu", "linux"})
+	expected := "label Linux of runner ci-1 is a read-only default label and cannot be changed"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+
+	if names := LabelNames(Runner{Labels: MatchingLabels(runner, []string{"GPU", "linux"})}); !reflect.DeepEqual(names, []string{"Linux", "gpu"}) {
+		t.Errorf("Expected matching labels [Linux gpu], got %v", names)
+	}
+}
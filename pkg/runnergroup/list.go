@@ -1,91 +1,63 @@
 package runnergroup
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 )
 
 // ListRunnerGroups fetches runner groups from the specified enterprise
 func (c *Client) ListRunnerGroups(enterpriseID string) ([]RunnerGroup, error) {
-	var allRunnerGroups []RunnerGroup
-	page := 1
-	perPage := 100
-
-	for {
-		// Build API endpoint with pagination parameters
-		endpoint := fmt.Sprintf("/enterprises/%s/actions/runner-groups?per_page=%d&page=%d",
-			enterpriseID, perPage, page)
-
-		// Call GitHub API
-		var response RunnerGroupsResponse
-		if err := c.CallAPIWithJSON(endpoint, &response); err != nil {
-			return nil, err
-		}
-
-		// Add runner groups from this page
-		allRunnerGroups = append(allRunnerGroups, response.RunnerGroups...)
-
-		// If we got fewer runner groups than per_page, this was the last page
-		if len(response.RunnerGroups) < perPage {
-			break
-		}
-
-		page++
-	}
+	return c.ListRunnerGroupsContext(context.Background(), enterpriseID)
+}
 
-	return allRunnerGroups, nil
+// ListRunnerGroupsContext is like ListRunnerGroups but honors cancellation of ctx
+func (c *Client) ListRunnerGroupsContext(ctx context.Context, enterpriseID string) ([]RunnerGroup, error) {
+	return c.ListGroups(ctx, EnterpriseScope(enterpriseID))
 }
 
 // ListOrgRunnerGroups fetches runner groups from the specified organization
 func (c *Client) ListOrgRunnerGroups(org string) ([]RunnerGroup, error) {
-	var allRunnerGroups []RunnerGroup
-	page := 1
-	perPage := 100
-
-	for {
-		// Build API endpoint with pagination parameters
-		endpoint := fmt.Sprintf("/orgs/%s/actions/runner-groups?per_page=%d&page=%d",
-			org, perPage, page)
+	return c.ListOrgRunnerGroupsContext(context.Background(), org)
+}
 
-		// Call GitHub API
-		var response RunnerGroupsResponse
-		if err := c.CallAPIWithJSON(endpoint, &response); err != nil {
-			return nil, err
-		}
+// ListOrgRunnerGroupsContext is like ListOrgRunnerGroups but honors cancellation of ctx
+func (c *Client) ListOrgRunnerGroupsContext(ctx context.Context, org string) ([]RunnerGroup, error) {
+	return c.ListGroups(ctx, OrganizationScope(org))
+}
 
-		// Add runner groups from this page
-		allRunnerGroups = append(allRunnerGroups, response.RunnerGroups...)
+// ListGroups fetches runner groups from the specified scope
+func (c *Client) ListGroups(ctx context.Context, scope Scope) ([]RunnerGroup, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
 
-		// If we got fewer runner groups than per_page, this was the last page
-		if len(response.RunnerGroups) < perPage {
-			break
-		}
+	endpoint := fmt.Sprintf("%s/actions/runner-groups", scope.Path())
+	return paginate(ctx, c, endpoint, c.Options.MaxItems, decodeRunnerGroups)
+}
 
-		page++
+// decodeRunnerGroups decodes a page of runner groups and the reported total count
+func decodeRunnerGroups(data []byte) ([]RunnerGroup, int, error) {
+	var response RunnerGroupsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse JSON response: %v", err)
 	}
-
-	return allRunnerGroups, nil
+	return response.RunnerGroups, response.TotalCount, nil
 }
 
-// FormatRunnerGroups formats runner groups for display
+// FormatRunnerGroups formats runner groups for display using the table format.
+//
+// Deprecated: it always emits ANSI color codes. Render RunnerGroupsTable with
+// a Formatter from LookupFormatter, which honors FormatOptions, instead.
 func FormatRunnerGroups(groups []RunnerGroup) string {
 	if len(groups) == 0 {
 		return ""
 	}
 
-	// Calculate max name width for alignment
-	nameWidth := GetMaxRunnerGroupNameLength(groups)
-
-	// Create header
-	paddedHeader := "Name" + strings.Repeat(" ", nameWidth-len("Name"))
-	header := fmt.Sprintf("ID\t%s  Visibility", paddedHeader)
-
-	// Create formatted lines
-	lines := []string{header}
-	for _, group := range groups {
-		line := FormatRunnerGroupWithStatus(group, nameWidth)
-		lines = append(lines, line)
+	var b strings.Builder
+	if err := formatTable(&b, RunnerGroupsTable(groups), FormatOptions{Color: true, TTY: true}); err != nil {
+		return ""
 	}
-
-	return strings.Join(lines, "\n")
-}
\ No newline at end of file
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,64 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// AddGroupRunner moves a runner into a runner group. A runner belongs to one
+// group at a time, so it leaves its current group.
+func (c *Client) AddGroupRunner(ctx context.Context, scope Scope, groupID, runnerID int) error {
+	if err := scope.validateGroups(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d/runners/%d", scope.Path(), groupID, runnerID)
+	return c.SendJSON(ctx, http.MethodPut, endpoint, nil, nil)
+}
+
+// RemoveGroupRunner removes a runner from a runner group, which returns it to the default group
+func (c *Client) RemoveGroupRunner(ctx context.Context, scope Scope, groupID, runnerID int) error {
+	if err := scope.validateGroups(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d/runners/%d", scope.Path(), groupID, runnerID)
+	return c.SendJSON(ctx, http.MethodDelete, endpoint, nil, nil)
+}
+
+// SelectRunners returns the runners given by ID or by exact name, in the order
+// given and without duplicates
+func SelectRunners(runners []Runner, refs []string) ([]Runner, error) {
+	var selected []Runner
+	seen := make(map[int]bool, len(refs))
+	for _, ref := range refs {
+		runner, ok := findRunner(runners, ref)
+		if !ok {
+			return nil, fmt.Errorf("runner %q not found", ref)
+		}
+		if !seen[runner.ID] {
+			seen[runner.ID] = true
+			selected = append(selected, runner)
+		}
+	}
+	return selected, nil
+}
+
+// findRunner looks up a runner by exact name, then by ID
+func findRunner(runners []Runner, ref string) (Runner, bool) {
+	for _, runner := range runners {
+		if runner.Name == ref {
+			return runner, true
+		}
+	}
+	if id, err := strconv.Atoi(ref); err == nil {
+		for _, runner := range runners {
+			if runner.ID == id {
+				return runner, true
+			}
+		}
+	}
+	return Runner{}, false
+}
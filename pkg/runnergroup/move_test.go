@@ -0,0 +1,76 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGroupRunnerChanges(t *testing.T) {
+	noContent := &Response{StatusCode: http.StatusNoContent, Header: http.Header{}}
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups/5/runners/42": noContent,
+	}}
+	client := NewClient().WithTransport(fake)
+	scope := OrganizationScope("test-org")
+
+	if err := client.AddGroupRunner(context.Background(), scope, 5, 42); err != nil {
+		t.Fatalf("Expected no error adding, got %v", err)
+	}
+	if err := client.RemoveGroupRunner(context.Background(), scope, 5, 42); err != nil {
+		t.Fatalf("Expected no error removing, got %v", err)
+	}
+
+	if len(fake.requests) != 2 || fake.requests[0].Method != http.MethodPut || fake.requests[1].Method != http.MethodDelete {
+		t.Errorf("Expected PUT then DELETE, got %v", fake.requests)
+	}
+
+	if err := client.AddGroupRunner(context.Background(), RepositoryScope("test-org/app"), 5, 42); err == nil {
+		t.Error("Expected an error for a repository scope")
+	}
+}
+
+func TestSelectRunners(t *testing.T) {
+	runners := []Runner{
+		{ID: 1, Name: "ci-arm-1"},
+		{ID: 2, Name: "ci-arm-2"},
+		{ID: 3, Name: "1"},
+	}
+
+	tests := []struct {
+		name        string
+		refs        []string
+		expectIDs   []int
+		expectError string
+	}{
+		{name: "by name", refs: []string{"ci-arm-2"}, expectIDs: []int{2}},
+		{name: "by ID", refs: []string{"2"}, expectIDs: []int{2}},
+		{name: "name wins over ID", refs: []string{"1"}, expectIDs: []int{3}},
+		{name: "duplicates", refs: []string{"ci-arm-1", "ci-arm-1", "ci-arm-2"}, expectIDs: []int{1, 2}},
+		{name: "unknown", refs: []string{"ci-x86-1"}, expectError: `runner "ci-x86-1" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := SelectRunners(runners, tt.refs)
+			if tt.expectError != "" {
+				if err == nil || err.Error() != tt.expectError {
+					t.Errorf("Expected error %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			var ids []int
+			for _, runner := range selected {
+				ids = append(ids, runner.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.expectIDs) {
+				t.Errorf("Expected IDs %v, got %v", tt.expectIDs, ids)
+			}
+		})
+	}
+}
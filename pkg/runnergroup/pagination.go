@@ -0,0 +1,103 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// perPage is the page size requested from list endpoints (the API maximum)
+const perPage = 100
+
+// linkNextRE matches the rel="next" entry of an RFC 5988 Link header
+var linkNextRE = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// paginate fetches every page of a list endpoint and returns the combined items.
+// The decode function extracts the items and the reported total_count from a page.
+// Pages are followed using the Link header, falling back to total_count and
+// finally to a short page when neither is available. A positive maxItems caps
+// the number of items returned.
+func paginate[T any](ctx context.Context, c *Client, endpoint string, maxItems int, decode func(data []byte) ([]T, int, error)) ([]T, error) {
+	var allItems []T
+	page := 1
+	next := pagePath(endpoint, page)
+
+	for next != "" {
+		// Stop paging as soon as the caller gives up
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Call GitHub API
+		resp, err := c.Do(ctx, &Request{Method: http.MethodGet, Path: next})
+		if err != nil {
+			return nil, err
+		}
+
+		items, totalCount, err := decode(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add items from this page
+		allItems = append(allItems, items...)
+
+		if maxItems > 0 && len(allItems) >= maxItems {
+			return allItems[:maxItems], nil
+		}
+
+		// Prefer the Link header when the transport provides it
+		if resp.Header.Get("Link") != "" {
+			next = nextPagePath(resp.Header)
+			continue
+		}
+
+		// Otherwise stop once total_count items were fetched or a short page was returned
+		if totalCount > 0 && len(allItems) >= totalCount {
+			break
+		}
+		if len(items) == 0 || (totalCount == 0 && len(items) < perPage) {
+			break
+		}
+
+		page++
+		next = pagePath(endpoint, page)
+	}
+
+	return allItems, nil
+}
+
+// pagePath appends pagination parameters to an endpoint
+func pagePath(endpoint string, page int) string {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%sper_page=%d&page=%d", endpoint, separator, perPage, page)
+}
+
+// nextPagePath returns the API path of the rel="next" link, or "" on the last page
+func nextPagePath(header http.Header) string {
+	for _, link := range header.Values("Link") {
+		match := linkNextRE.FindStringSubmatch(link)
+		if match == nil {
+			continue
+		}
+
+		u, err := url.Parse(match[1])
+		if err != nil {
+			return ""
+		}
+
+		// Strip the GitHub Enterprise Server API prefix so the path is host-relative
+		path := strings.TrimPrefix(u.Path, "/api/v3")
+		if u.RawQuery != "" {
+			path += "?" + u.RawQuery
+		}
+		return path
+	}
+	return ""
+}
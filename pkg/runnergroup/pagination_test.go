@@ -0,0 +1,142 @@
+package runnergroup
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// runnersPage builds a runners list response body with count runners starting at id
+func runnersPage(start, count, total int) string {
+	runners := make([]string, 0, count)
+	for i := start; i < start+count; i++ {
+		runners = append(runners, fmt.Sprintf(`{"id":%d,"name":"runner-%d","status":"online","busy":false}`, i, i))
+	}
+	return fmt.Sprintf(`{"total_count":%d,"runners":[%s]}`, total, strings.Join(runners, ","))
+}
+
+func TestPaginate_FollowsLinkHeader(t *testing.T) {
+	first := jsonResponse(runnersPage(1, 2, 0))
+	first.Header.Set("Link", `<https://github.example.com/api/v3/organizations/1/actions/runner-groups/5/runners?per_page=100&page=2>; rel="next", <https://github.example.com/api/v3/organizations/1/actions/runner-groups/5/runners?per_page=100&page=2>; rel="last"`)
+	second := jsonResponse(runnersPage(3, 1, 0))
+	second.Header.Set("Link", `<https://github.example.com/api/v3/organizations/1/actions/runner-groups/5/runners?per_page=100&page=1>; rel="first"`)
+
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups/5/runners?per_page=100&page=1":   first,
+		"/organizations/1/actions/runner-groups/5/runners?per_page=100&page=2": second,
+	}}
+
+	runners, err := NewClient().WithTransport(fake).GetOrgRunners("test-org", "5")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(runners) != 3 {
+		t.Errorf("Expected 3 runners, got %d", len(runners))
+	}
+	if len(fake.requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(fake.requests))
+	}
+}
+
+func TestPaginate_TotalCountFallback(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/enterprises/test-enterprise/actions/runner-groups/1/runners?per_page=100&page=1": jsonResponse(runnersPage(1, 100, 200)),
+		"/enterprises/test-enterprise/actions/runner-groups/1/runners?per_page=100&page=2": jsonResponse(runnersPage(101, 100, 200)),
+	}}
+
+	runners, err := NewClient().WithTransport(fake).GetRunners("test-enterprise", "1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(runners) != 200 {
+		t.Errorf("Expected 200 runners, got %d", len(runners))
+	}
+	// No extra empty request should be made on an exact multiple of the page size
+	if len(fake.requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(fake.requests))
+	}
+}
+
+func TestPaginate_ShortPageFallback(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups?per_page=100&page=1": jsonResponse(`{"runner_groups":[{"id":1,"name":"Default"},{"id":2,"name":"gpu"}]}`),
+	}}
+
+	groups, err := NewClient().WithTransport(fake).ListOrgRunnerGroups("test-org")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(groups) != 2 {
+		t.Errorf("Expected 2 groups, got %d", len(groups))
+	}
+	if len(fake.requests) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(fake.requests))
+	}
+}
+
+func TestPaginate_MaxItems(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/enterprises/test-enterprise/actions/runner-groups/1/runners?per_page=100&page=1": jsonResponse(runnersPage(1, 100, 300)),
+		"/enterprises/test-enterprise/actions/runner-groups/1/runners?per_page=100&page=2": jsonResponse(runnersPage(101, 100, 300)),
+	}}
+
+	client := NewClient().WithTransport(fake)
+	client.Options.MaxItems = 150
+
+	runners, err := client.GetRunners("test-enterprise", "1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(runners) != 150 {
+		t.Errorf("Expected 150 runners, got %d", len(runners))
+	}
+	if len(fake.requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(fake.requests))
+	}
+}
+
+func TestNextPagePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		expected string
+	}{
+		{
+			name:     "no link header",
+			link:     "",
+			expected: "",
+		},
+		{
+			name:     "github.com next link",
+			link:     `<https://api.github.com/orgs/test-org/actions/runner-groups?per_page=100&page=3>; rel="next", <https://api.github.com/orgs/test-org/actions/runner-groups?per_page=100&page=5>; rel="last"`,
+			expected: "/orgs/test-org/actions/runner-groups?per_page=100&page=3",
+		},
+		{
+			name:     "enterprise server next link",
+			link:     `<https://github.example.com/api/v3/enterprises/test/actions/runner-groups?per_page=100&page=2>; rel="next"`,
+			expected: "/enterprises/test/actions/runner-groups?per_page=100&page=2",
+		},
+		{
+			name:     "last page without next",
+			link:     `<https://api.github.com/orgs/test-org/actions/runner-groups?per_page=100&page=1>; rel="first", <https://api.github.com/orgs/test-org/actions/runner-groups?per_page=100&page=4>; rel="prev"`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.link != "" {
+				header.Set("Link", tt.link)
+			}
+			if result := nextPagePath(header); result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package runnergroup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DeleteRunner removes a self-hosted runner from an enterprise, organization or repository
+func (c *Client) DeleteRunner(ctx context.Context, scope Scope, runnerID int) error {
+	if err := scope.validate(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/actions/runners/%d", scope.Path(), runnerID)
+	return c.SendJSON(ctx, http.MethodDelete, endpoint, nil, nil)
+}
+
+// OfflineHistory records when runners were first seen offline. The API does
+// not report how long a runner has been offline, so the history is kept
+// locally and is only as accurate as the commands that observe runners.
+type OfflineHistory struct {
+	// Runners maps a runner key (see OfflineHistoryKey) to the offline runner
+	Runners map[string]OfflineRunner `json:"runners"`
+}
+
+// OfflineRunner records when a runner was first seen offline and in which runner group
+type OfflineRunner struct {
+	Since   time.Time `json:"since"`
+	GroupID int       `json:"group_id"`
+}
+
+// OfflineHistoryKey returns the key of a runner of a scope on a GitHub host
+func OfflineHistoryKey(host string, scope Scope, runnerID int) string {
+	return fmt.Sprintf("%s%d", offlineHistoryPrefix(host, scope), runnerID)
+}
+
+// offlineHistoryPrefix returns the common prefix of the keys of a scope on a GitHub host
+func offlineHistoryPrefix(host string, scope Scope) string {
+	return fmt.Sprintf("%s%s/actions/runners/", host, scope.Path())
+}
+
+// LoadOfflineHistory reads the history from path. A missing file is an empty history.
+func LoadOfflineHistory(path string) (*OfflineHistory, error) {
+	history := &OfflineHistory{Runners: map[string]OfflineRunner{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read runner history: %v", err)
+	}
+	if err := json.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("failed to parse runner history %s: %v", path, err)
+	}
+	if history.Runners == nil {
+		history.Runners = map[string]OfflineRunner{}
+	}
+	return history, nil
+}
+
+// Save writes the history to path, creating its directory if needed
+func (h *OfflineHistory) Save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode runner history: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to write runner history: %v", err)
+	}
+
+	// Write to a temporary file first so an interrupted write keeps the old history
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write runner history: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write runner history: %v", err)
+	}
+	return nil
+}
+
+// Observe records the offline runners of a runner group that are not in the
+// history yet. It forgets the runners that are online again and those recorded
+// for the group that are no longer in it, e.g. because they were removed or
+// moved to another group.
+func (h *OfflineHistory) Observe(host string, scope Scope, groupID int, runners []Runner, now time.Time) {
+	listed := make(map[string]bool, len(runners))
+	for _, runner := range runners {
+		key := OfflineHistoryKey(host, scope, runner.ID)
+		listed[key] = true
+		if GetRunnerStatus(runner) != "offline" {
+			delete(h.Runners, key)
+			continue
+		}
+		entry, ok := h.Runners[key]
+		if !ok {
+			entry.Since = now
+		}
+		entry.GroupID = groupID
+		h.Runners[key] = entry
+	}
+
+	prefix := offlineHistoryPrefix(host, scope)
+	for key, entry := range h.Runners {
+		if entry.GroupID == groupID && strings.HasPrefix(key, prefix) && !listed[key] {
+			delete(h.Runners, key)
+		}
+	}
+}
+
+// OfflineSince returns when the runner was first seen offline
+func (h *OfflineHistory) OfflineSince(host string, scope Scope, runner Runner) (time.Time, bool) {
+	entry, ok := h.Runners[OfflineHistoryKey(host, scope, runner.ID)]
+	return entry.Since, ok
+}
+
+// Forget removes a runner from the history, e.g. after it was deleted
+func (h *OfflineHistory) Forget(host string, scope Scope, runnerID int) {
+	delete(h.Runners, OfflineHistoryKey(host, scope, runnerID))
+}
+
+// FilterRunnersOfflineFor returns the runners that were first seen offline at
+// least d before now. Runners missing from the history are left out.
+func (h *OfflineHistory) FilterRunnersOfflineFor(host string, scope Scope, runners []Runner, d time.Duration, now time.Time) []Runner {
+	var filteredRunners []Runner
+	for _, runner := range runners {
+		if since, ok := h.OfflineSince(host, scope, runner); ok && now.Sub(since) >= d {
+			filteredRunners = append(filteredRunners, runner)
+		}
+	}
+	return filteredRunners
+}
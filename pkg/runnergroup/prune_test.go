@@ -0,0 +1,102 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDeleteRunner(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runners/42": {StatusCode: http.StatusNoContent, Header: http.Header{}},
+	}}
+
+	if err := NewClient().WithTransport(fake).DeleteRunner(context.Background(), OrganizationScope("test-org"), 42); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fake.requests[0].Method != http.MethodDelete {
+		t.Errorf("Expected DELETE, got %s", fake.requests[0].Method)
+	}
+}
+
+func TestOfflineHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "offline-runners.json")
+	scope := OrganizationScope("test-org")
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	history, err := LoadOfflineHistory(path)
+	if err != nil {
+		t.Fatalf("Expected a missing file to load as an empty history, got %v", err)
+	}
+
+	runners := []Runner{
+		{ID: 1, Name: "old", Status: "offline"},
+		{ID: 2, Name: "online", Status: "online"},
+	}
+	history.Observe("github.com", scope, 5, runners, start)
+
+	// A later observation keeps the first-seen time and records new offline runners
+	runners = append(runners, Runner{ID: 3, Name: "new", Status: "offline"})
+	history.Observe("github.com", scope, 5, runners, start.Add(48*time.Hour))
+
+	if err := history.Save(path); err != nil {
+		t.Fatalf("Expected no error saving, got %v", err)
+	}
+	history, err = LoadOfflineHistory(path)
+	if err != nil {
+		t.Fatalf("Expected no error loading, got %v", err)
+	}
+
+	if since, ok := history.OfflineSince("github.com", scope, runners[0]); !ok || !since.Equal(start) {
+		t.Errorf("Expected runner 1 offline since %v, got %v (%v)", start, since, ok)
+	}
+	if _, ok := history.OfflineSince("github.com", scope, runners[1]); ok {
+		t.Error("Expected online runners not to be recorded")
+	}
+
+	old := history.FilterRunnersOfflineFor("github.com", scope, runners, 24*time.Hour, start.Add(49*time.Hour))
+	if len(old) != 1 || old[0].ID != 1 {
+		t.Errorf("Expected only runner 1 to be offline for a day, got %v", old)
+	}
+
+	// Runners that come back online are forgotten
+	runners[0].Status = "online"
+	history.Observe("github.com", scope, 5, runners, start.Add(72*time.Hour))
+	if _, ok := history.OfflineSince("github.com", scope, runners[0]); ok {
+		t.Error("Expected runner 1 to be forgotten once online")
+	}
+
+	history.Forget("github.com", scope, 3)
+	if len(history.Runners) != 0 {
+		t.Errorf("Expected an empty history, got %v", history.Runners)
+	}
+}
+
+func TestOfflineHistory_ObserveDropsMissingRunners(t *testing.T) {
+	scope := OrganizationScope("test-org")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := &OfflineHistory{Runners: map[string]OfflineRunner{}}
+
+	history.Observe("github.com", scope, 5, []Runner{{ID: 1, Status: "offline"}, {ID: 2, Status: "offline"}}, now)
+	history.Observe("github.com", scope, 6, []Runner{{ID: 3, Status: "offline"}}, now)
+	history.Observe("github.com", OrganizationScope("other-org"), 5, []Runner{{ID: 4, Status: "offline"}}, now)
+
+	// Runner 1 was deleted elsewhere and runner 2 moved to group 6
+	history.Observe("github.com", scope, 6, []Runner{{ID: 2, Status: "offline"}, {ID: 3, Status: "offline"}}, now.Add(time.Hour))
+	history.Observe("github.com", scope, 5, nil, now.Add(time.Hour))
+
+	if _, ok := history.Runners[OfflineHistoryKey("github.com", scope, 1)]; ok {
+		t.Error("Expected runner 1 to be dropped once it is no longer in its group")
+	}
+	if entry, ok := history.Runners[OfflineHistoryKey("github.com", scope, 2)]; !ok || entry.GroupID != 6 || !entry.Since.Equal(now) {
+		t.Errorf("Expected runner 2 to be kept with group 6 and its first-seen time, got %v (%v)", entry, ok)
+	}
+	if _, ok := history.Runners[OfflineHistoryKey("github.com", scope, 3)]; !ok {
+		t.Error("Expected runner 3 of another group to be kept")
+	}
+	if _, ok := history.Runners[OfflineHistoryKey("github.com", OrganizationScope("other-org"), 4)]; !ok {
+		t.Error("Expected runners of another scope to be kept")
+	}
+}
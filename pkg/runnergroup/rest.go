@@ -0,0 +1,134 @@
+package runnergroup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// RESTTransport sends requests in-process using go-gh's HTTP client,
+// reusing the authentication configured for the gh CLI
+type RESTTransport struct {
+	opts api.ClientOptions
+	// baseURL overrides the API root derived from the request hostname
+	baseURL string
+
+	mu sync.Mutex
+	// clients holds one HTTP client per hostname, each with its own token
+	clients map[string]*http.Client
+}
+
+// NewRESTTransport creates a REST transport for the given hostname and headers.
+// An empty hostname resolves to the gh default host (including GH_HOST);
+// requests naming another hostname are sent there instead.
+// It returns an error when no gh authentication token is available.
+func NewRESTTransport(hostname string, headers map[string]string) (*RESTTransport, error) {
+	return newRESTTransport(api.ClientOptions{
+		Host:    hostname,
+		Headers: headers,
+	})
+}
+
+func newRESTTransport(opts api.ClientOptions) (*RESTTransport, error) {
+	if opts.Host == "" {
+		opts.Host, _ = auth.DefaultHost()
+	}
+
+	t := &RESTTransport{opts: opts, clients: map[string]*http.Client{}}
+	if _, err := t.clientFor(opts.Host); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// clientFor returns the HTTP client for the hostname, creating it on first use
+func (t *RESTTransport) clientFor(hostname string) (*http.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if client, ok := t.clients[hostname]; ok {
+		return client, nil
+	}
+
+	opts := t.opts
+	opts.Host = hostname
+	// go-gh adds its default headers to the given map; keep the configured ones intact
+	opts.Headers = make(map[string]string, len(t.opts.Headers))
+	for key, value := range t.opts.Headers {
+		opts.Headers[key] = value
+	}
+	// A token given for the default host must not be sent to other hosts
+	if !strings.EqualFold(hostname, t.opts.Host) {
+		opts.AuthToken = ""
+	}
+
+	client, err := api.NewHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	t.clients[hostname] = client
+	return client, nil
+}
+
+// Do sends the request with the HTTP client for its hostname and returns the
+// response, keeping the raw body of unsuccessful requests for error details
+func (t *RESTTransport) Do(ctx context.Context, req *Request) (*Response, error) {
+	host := req.Hostname
+	if host == "" {
+		host = t.opts.Host
+	}
+	client, err := t.clientFor(host)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := t.baseURL
+	if baseURL == "" {
+		baseURL = apiBaseURL(host)
+	}
+
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+
+	url := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(req.Path, "/")
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	// Request headers take precedence over the defaults of the HTTP client
+	for key, value := range req.Headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return &Response{
+		StatusCode: httpResp.StatusCode,
+		Header:     httpResp.Header,
+		Body:       data,
+	}, nil
+}
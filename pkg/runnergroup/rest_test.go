@@ -0,0 +1,173 @@
+package runnergroup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newTestRESTTransport(t *testing.T, handler roundTripFunc) *RESTTransport {
+	t.Helper()
+	transport, err := newRESTTransport(api.ClientOptions{
+		Host:         "github.example.com",
+		AuthToken:    "secret",
+		Headers:      map[string]string{"X-GitHub-Api-Version": "2022-11-28"},
+		Transport:    handler,
+		LogIgnoreEnv: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create REST transport: %v", err)
+	}
+	return transport
+}
+
+func TestRESTTransport_Do(t *testing.T) {
+	transport := newTestRESTTransport(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://github.example.com/api/v3/orgs/test-org/actions/runner-groups?per_page=100&page=1" {
+			t.Errorf("Unexpected request URL %q", req.URL.String())
+		}
+		if req.Header.Get("X-GitHub-Api-Version") != "2022-11-28" {
+			t.Errorf("Expected configured headers, got %v", req.Header)
+		}
+		if req.Header.Get("Authorization") != "token secret" {
+			t.Errorf("Expected auth header, got %q", req.Header.Get("Authorization"))
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}, "X-Ratelimit-Remaining": []string{"4999"}},
+			Body:       io.NopCloser(strings.NewReader(`{"total_count":0,"runner_groups":[]}`)),
+			Request:    req,
+		}, nil
+	})
+
+	resp, err := transport.Do(context.Background(), &Request{Path: "/orgs/test-org/actions/runner-groups?per_page=100&page=1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") != "4999" {
+		t.Errorf("Expected rate limit header, got %v", resp.Header)
+	}
+	if string(resp.Body) != `{"total_count":0,"runner_groups":[]}` {
+		t.Errorf("Unexpected body %q", resp.Body)
+	}
+}
+
+func TestRESTTransport_DoErrorStatus(t *testing.T) {
+	transport := newTestRESTTransport(t, func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"message":"Not Found"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	resp, err := transport.Do(context.Background(), &Request{Path: "/orgs/missing/actions/runner-groups"})
+	if err != nil {
+		t.Fatalf("Expected response for error status, got %v", err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+	if !strings.Contains(string(resp.Body), "Not Found") {
+		t.Errorf("Expected message in body, got %q", resp.Body)
+	}
+}
+
+func TestRESTTransport_DoKeepsErrorBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/test-org/actions/runner-groups/99" {
+			t.Errorf("Unexpected request path %q", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found","documentation_url":"https://docs.github.com/rest/actions/self-hosted-runner-groups"}`))
+	}))
+	defer server.Close()
+
+	transport, err := newRESTTransport(api.ClientOptions{
+		Host:         "github.example.com",
+		AuthToken:    "secret",
+		LogIgnoreEnv: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create REST transport: %v", err)
+	}
+	transport.baseURL = server.URL
+
+	client := NewClient().WithTransport(transport).WithMaxRetries(0)
+	_, err = client.GetGroup(context.Background(), OrganizationScope("test-org"), 99)
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected NotFoundError, got %v", err)
+	}
+	if notFound.Message != "Not Found" {
+		t.Errorf("Expected message %q, got %q", "Not Found", notFound.Message)
+	}
+	if notFound.DocumentationURL != "https://docs.github.com/rest/actions/self-hosted-runner-groups" {
+		t.Errorf("Expected documentation URL, got %q", notFound.DocumentationURL)
+	}
+}
+
+func TestRESTTransport_DoRequestHeadersAndHostname(t *testing.T) {
+	t.Setenv("GH_ENTERPRISE_TOKEN", "other-secret")
+
+	var urls []string
+	transport := newTestRESTTransport(t, func(req *http.Request) (*http.Response, error) {
+		urls = append(urls, req.URL.String())
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected request Content-Type header, got %q", req.Header.Get("Content-Type"))
+		}
+		if req.Header.Get("X-GitHub-Api-Version") != "2022-11-28" {
+			t.Errorf("Expected configured headers, got %v", req.Header)
+		}
+		if req.URL.Host == "ghe.example.com" && req.Header.Get("Authorization") != "token other-secret" {
+			t.Errorf("Expected the token of the request host, got %q", req.Header.Get("Authorization"))
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Request:    req,
+		}, nil
+	})
+
+	for _, hostname := range []string{"", "ghe.example.com"} {
+		_, err := transport.Do(context.Background(), &Request{
+			Method:   http.MethodPost,
+			Path:     "/orgs/test-org/actions/runner-groups",
+			Headers:  map[string]string{"Content-Type": "application/json"},
+			Hostname: hostname,
+			Body:     []byte(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	expected := []string{
+		"https://github.example.com/api/v3/orgs/test-org/actions/runner-groups",
+		"https://ghe.example.com/api/v3/orgs/test-org/actions/runner-groups",
+	}
+	if strings.Join(urls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected requests to %v, got %v", expected, urls)
+	}
+}
@@ -0,0 +1,102 @@
+package runnergroup
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried by default
+	DefaultMaxRetries = 3
+
+	// baseRetryDelay is the initial backoff delay, doubled on every attempt
+	baseRetryDelay = time.Second
+
+	// maxBackoffDelay caps the exponential backoff delay
+	maxBackoffDelay = time.Minute
+
+	// minRateLimitDelay is the shortest wait after a rate limit that gives no
+	// reset time; GitHub asks clients to wait at least a minute
+	minRateLimitDelay = time.Minute
+
+	// maxRetryDelay is the longest the client will wait before giving up on a retry
+	maxRetryDelay = 15 * time.Minute
+)
+
+// retryDelay reports whether the response should be retried and how long to wait first.
+// Primary and secondary rate limits honor Retry-After and X-RateLimit-Reset and
+// otherwise wait at least a minute, while transient server errors use
+// exponential backoff with jitter.
+func retryDelay(resp *Response, attempt int, now time.Time) (time.Duration, bool) {
+	switch {
+	case isRateLimited(resp):
+		if delay, ok := retryAfter(resp.Header); ok {
+			return delay, delay <= maxRetryDelay
+		}
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			if reset, ok := rateLimitReset(resp.Header); ok {
+				delay := max(reset.Sub(now), 0) + time.Second
+				return delay, delay <= maxRetryDelay
+			}
+		}
+		return minRateLimitDelay + backoff(attempt), true
+	case resp.StatusCode == http.StatusInternalServerError,
+		resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return backoff(attempt), true
+	}
+	return 0, false
+}
+
+// isRateLimited reports whether the response was rejected by a primary or secondary rate limit
+func isRateLimited(resp *Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+	if resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(string(resp.Body)), "rate limit")
+}
+
+// retryAfter parses the Retry-After header given in seconds
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// rateLimitReset parses the X-RateLimit-Reset header given as a Unix timestamp
+func rateLimitReset(header http.Header) (time.Time, bool) {
+	value := header.Get("X-RateLimit-Reset")
+	if value == "" {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// backoff returns an exponential delay for the given attempt with random jitter
+func backoff(attempt int) time.Duration {
+	delay := baseRetryDelay << attempt
+	if delay <= 0 || delay > maxBackoffDelay {
+		delay = maxBackoffDelay
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
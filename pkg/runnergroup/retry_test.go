@@ -0,0 +1,196 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient returns a client talking to an httptest server that replies
+// with the given handlers in order, recording requested sleeps instead of sleeping
+func newRetryTestClient(t *testing.T, handlers ...http.HandlerFunc) (*Client, *[]time.Duration, *int) {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests >= len(handlers) {
+			t.Errorf("Unexpected request %d", requests+1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		handlers[requests](w, r)
+		requests++
+	}))
+	t.Cleanup(server.Close)
+
+	var sleeps []time.Duration
+	client := NewClient().WithTransport(&HTTPTransport{BaseURL: server.URL})
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+
+	return client, &sleeps, &requests
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"total_count":0,"runner_groups":[]}`))
+}
+
+func statusHandler(status int, header map[string]string, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for key, value := range header {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestClient_RetriesTransientServerErrors(t *testing.T) {
+	client, sleeps, requests := newRetryTestClient(t,
+		statusHandler(http.StatusBadGateway, nil, "Bad Gateway"),
+		statusHandler(http.StatusServiceUnavailable, nil, "Service Unavailable"),
+		okHandler,
+	)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if *requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", *requests)
+	}
+	if len(*sleeps) != 2 {
+		t.Fatalf("Expected 2 backoff sleeps, got %v", *sleeps)
+	}
+	for i, d := range *sleeps {
+		base := baseRetryDelay << i
+		if d < base/2 || d > base {
+			t.Errorf("Expected backoff %d within [%v, %v], got %v", i, base/2, base, d)
+		}
+	}
+}
+
+func TestClient_RetryAfterOnSecondaryRateLimit(t *testing.T) {
+	client, sleeps, requests := newRetryTestClient(t,
+		statusHandler(http.StatusForbidden, map[string]string{"Retry-After": "7"}, `{"message":"You have exceeded a secondary rate limit."}`),
+		statusHandler(http.StatusTooManyRequests, map[string]string{"Retry-After": "3"}, `{"message":"Too Many Requests"}`),
+		okHandler,
+	)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if *requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", *requests)
+	}
+	expected := []time.Duration{7 * time.Second, 3 * time.Second}
+	if fmt.Sprint(*sleeps) != fmt.Sprint(expected) {
+		t.Errorf("Expected sleeps %v, got %v", expected, *sleeps)
+	}
+}
+
+func TestClient_SecondaryRateLimitWithoutRetryAfter(t *testing.T) {
+	client, sleeps, _ := newRetryTestClient(t,
+		statusHandler(http.StatusForbidden, nil, `{"message":"You have exceeded a secondary rate limit."}`),
+		okHandler,
+	)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Without a reset time the client waits at least a minute, plus jitter
+	if len(*sleeps) != 1 || (*sleeps)[0] < minRateLimitDelay || (*sleeps)[0] > minRateLimitDelay+baseRetryDelay {
+		t.Errorf("Expected a single wait of about a minute, got %v", *sleeps)
+	}
+}
+
+func TestClient_WaitsForPrimaryRateLimitReset(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).Unix()
+	client, sleeps, _ := newRetryTestClient(t,
+		statusHandler(http.StatusForbidden, map[string]string{
+			"X-RateLimit-Remaining": "0",
+			"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+		}, `{"message":"API rate limit exceeded"}`),
+		okHandler,
+	)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(*sleeps) != 1 || (*sleeps)[0] < 25*time.Second || (*sleeps)[0] > 32*time.Second {
+		t.Errorf("Expected to wait until the rate limit reset, got %v", *sleeps)
+	}
+}
+
+func TestClient_GivesUpAfterMaxRetries(t *testing.T) {
+	client, sleeps, requests := newRetryTestClient(t,
+		statusHandler(http.StatusBadGateway, nil, "Bad Gateway"),
+		statusHandler(http.StatusBadGateway, nil, "Bad Gateway"),
+	)
+	client.WithMaxRetries(1)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err == nil {
+		t.Fatal("Expected error after exhausting retries, got nil")
+	}
+
+	if *requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", *requests)
+	}
+	if len(*sleeps) != 1 {
+		t.Errorf("Expected 1 sleep, got %v", *sleeps)
+	}
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	client, sleeps, requests := newRetryTestClient(t,
+		statusHandler(http.StatusNotFound, nil, `{"message":"Not Found"}`),
+	)
+
+	if _, err := client.ListOrgRunnerGroups("test-org"); err == nil {
+		t.Fatal("Expected error for 404, got nil")
+	}
+
+	if *requests != 1 {
+		t.Errorf("Expected 1 request, got %d", *requests)
+	}
+	if len(*sleeps) != 0 {
+		t.Errorf("Expected no sleeps, got %v", *sleeps)
+	}
+}
+
+func TestClient_DoesNotRetryPostOnServerError(t *testing.T) {
+	client, sleeps, requests := newRetryTestClient(t,
+		statusHandler(http.StatusBadGateway, nil, "Bad Gateway"),
+	)
+
+	req := CreateGroupRequest{Name: "gpu"}
+	if _, err := client.CreateGroup(context.Background(), OrganizationScope("test-org"), req); err == nil {
+		t.Fatal("Expected error for 502, got nil")
+	}
+
+	if *requests != 1 {
+		t.Errorf("Expected 1 request, got %d", *requests)
+	}
+	if len(*sleeps) != 0 {
+		t.Errorf("Expected no sleeps, got %v", *sleeps)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(attempt)
+		if d <= 0 || d > maxBackoffDelay {
+			t.Errorf("Expected backoff for attempt %d within (0, %v], got %v", attempt, maxBackoffDelay, d)
+		}
+	}
+}
@@ -1,85 +1,77 @@
 package runnergroup
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/text"
 )
 
 // GetRunners fetches runners from the specified enterprise and runner group
 func (c *Client) GetRunners(enterpriseID, runnerGroupID string) ([]Runner, error) {
+	return c.GetRunnersContext(context.Background(), enterpriseID, runnerGroupID)
+}
+
+// GetRunnersContext is like GetRunners but honors cancellation of ctx
+func (c *Client) GetRunnersContext(ctx context.Context, enterpriseID, runnerGroupID string) ([]Runner, error) {
 	// Validate runner group ID is a number
-	if _, err := strconv.Atoi(runnerGroupID); err != nil {
+	groupID, err := strconv.Atoi(runnerGroupID)
+	if err != nil {
 		return nil, fmt.Errorf("invalid runner group ID: %s (must be a number)", runnerGroupID)
 	}
 
-	var allRunners []Runner
-	page := 1
-	perPage := 100
-
-	for {
-		// Build API endpoint with pagination parameters
-		endpoint := fmt.Sprintf("/enterprises/%s/actions/runner-groups/%s/runners?per_page=%d&page=%d",
-			enterpriseID, runnerGroupID, perPage, page)
-
-		// Call GitHub API
-		var response RunnersResponse
-		if err := c.CallAPIWithJSON(endpoint, &response); err != nil {
-			return nil, err
-		}
-
-		// Add runners from this page
-		allRunners = append(allRunners, response.Runners...)
-
-		// If we got fewer runners than per_page, this was the last page
-		if len(response.Runners) < perPage {
-			break
-		}
-
-		page++
-	}
-
-	return allRunners, nil
+	return c.ListGroupRunners(ctx, EnterpriseScope(enterpriseID), groupID)
 }
 
 // GetOrgRunners fetches runners from the specified organization and runner group
 func (c *Client) GetOrgRunners(org, runnerGroupID string) ([]Runner, error) {
+	return c.GetOrgRunnersContext(context.Background(), org, runnerGroupID)
+}
+
+// GetOrgRunnersContext is like GetOrgRunners but honors cancellation of ctx
+func (c *Client) GetOrgRunnersContext(ctx context.Context, org, runnerGroupID string) ([]Runner, error) {
 	// Validate runner group ID is a number
-	if _, err := strconv.Atoi(runnerGroupID); err != nil {
+	groupID, err := strconv.Atoi(runnerGroupID)
+	if err != nil {
 		return nil, fmt.Errorf("invalid runner group ID: %s (must be a number)", runnerGroupID)
 	}
 
-	var allRunners []Runner
-	page := 1
-	perPage := 100
-
-	for {
-		// Build API endpoint with pagination parameters
-		endpoint := fmt.Sprintf("/orgs/%s/actions/runner-groups/%s/runners?per_page=%d&page=%d",
-			org, runnerGroupID, perPage, page)
-
-		// Call GitHub API
-		var response RunnersResponse
-		if err := c.CallAPIWithJSON(endpoint, &response); err != nil {
-			return nil, err
-		}
+	return c.ListGroupRunners(ctx, OrganizationScope(org), groupID)
+}
 
-		// Add runners from this page
-		allRunners = append(allRunners, response.Runners...)
+// ListGroupRunners fetches runners in the specified runner group of a scope
+func (c *Client) ListGroupRunners(ctx context.Context, scope Scope, groupID int) ([]Runner, error) {
+	if err := scope.validateGroups(); err != nil {
+		return nil, err
+	}
 
-		// If we got fewer runners than per_page, this was the last page
-		if len(response.Runners) < perPage {
-			break
-		}
+	endpoint := fmt.Sprintf("%s/actions/runner-groups/%d/runners", scope.Path(), groupID)
+	return paginate(ctx, c, endpoint, c.Options.MaxItems, decodeRunners)
+}
 
-		page++
+// ListRunners fetches every self-hosted runner registered directly at a scope
+func (c *Client) ListRunners(ctx context.Context, scope Scope) ([]Runner, error) {
+	if err := scope.validate(); err != nil {
+		return nil, err
 	}
 
-	return allRunners, nil
+	endpoint := fmt.Sprintf("%s/actions/runners", scope.Path())
+	return paginate(ctx, c, endpoint, c.Options.MaxItems, decodeRunners)
 }
 
+// decodeRunners decodes a page of runners and the reported total count
+func decodeRunners(data []byte) ([]Runner, int, error) {
+	var response RunnersResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse JSON response: %v", err)
+	}
+	return response.Runners, response.TotalCount, nil
+}
 
 // ANSI color codes
 const (
@@ -117,98 +109,76 @@ func SortRunners(runners []Runner) {
 	})
 }
 
-// GetMaxRunnerNameLength returns the maximum length of runner names
+// GetMaxRunnerNameLength returns the maximum display width of runner names.
+//
+// Deprecated: use RunnersTable with a Formatter from LookupFormatter, which
+// computes column widths itself.
 func GetMaxRunnerNameLength(runners []Runner) int {
 	maxLen := len("Runners") // Header length as minimum
 	for _, runner := range runners {
-		if len(runner.Name) > maxLen {
-			maxLen = len(runner.Name)
+		if width := text.DisplayWidth(runner.Name); width > maxLen {
+			maxLen = width
 		}
 	}
 	return maxLen
 }
 
-// FormatRunnerWithStatus formats a runner with colored status
+// FormatRunnerWithStatus formats a runner with colored status.
+//
+// Deprecated: it always emits ANSI color codes. Render RunnersTable with a
+// Formatter from LookupFormatter, which honors FormatOptions, instead.
 func FormatRunnerWithStatus(runner Runner) string {
-	var status string
-
-	if runner.Status == "online" && runner.Busy {
-		// Active (orange)
-		status = fmt.Sprintf("%s● Active%s", ColorOrange, ColorReset)
-	} else if runner.Status == "online" && !runner.Busy {
-		// Idle (green)
-		status = fmt.Sprintf("%s● Idle%s", ColorGreen, ColorReset)
-	} else {
-		// Offline (gray)
-		status = fmt.Sprintf("%s● Offline%s", ColorGray, ColorReset)
-	}
-
-	return fmt.Sprintf("%s\t%s", runner.Name, status)
+	return fmt.Sprintf("%s\t%s", runner.Name, statusCell(runner).colored())
 }
 
-// FormatRunnerWithStatusAligned formats a runner with colored status and aligned columns
+// FormatRunnerWithStatusAligned formats a runner with colored status and aligned columns.
+//
+// Deprecated: it always emits ANSI color codes. Render RunnersTable with a
+// Formatter from LookupFormatter, which honors FormatOptions, instead.
 func FormatRunnerWithStatusAligned(runner Runner, nameWidth int) string {
-	var status string
-
-	if runner.Status == "online" && runner.Busy {
-		// Active (orange)
-		status = fmt.Sprintf("%s● Active%s", ColorOrange, ColorReset)
-	} else if runner.Status == "online" && !runner.Busy {
-		// Idle (green)
-		status = fmt.Sprintf("%s● Idle%s", ColorGreen, ColorReset)
-	} else {
-		// Offline (gray)
-		status = fmt.Sprintf("%s● Offline%s", ColorGray, ColorReset)
-	}
-
 	// Pad runner name to align columns
-	paddedName := runner.Name + strings.Repeat(" ", nameWidth-len(runner.Name))
-	return fmt.Sprintf("%s  %s", paddedName, status)
+	paddedName := text.PadRight(nameWidth, runner.Name)
+	return fmt.Sprintf("%s  %s", paddedName, statusCell(runner).colored())
 }
 
-
-// PrintHeaderAligned prints the table header with aligned columns
+// PrintHeaderAligned prints the runners table header with aligned columns.
+//
+// Deprecated: use RunnersTable with a Formatter from LookupFormatter, which
+// computes column widths itself.
 func PrintHeaderAligned(nameWidth int) {
-	paddedHeader := "Runners" + strings.Repeat(" ", nameWidth-len("Runners"))
-	fmt.Printf("%s  %s\n", paddedHeader, "Status")
+	fmt.Printf("%s  %s\n", text.PadRight(nameWidth, "Runners"), "Status")
 }
 
-
-// GetMaxRunnerGroupNameLength returns the maximum length of runner group names
+// GetMaxRunnerGroupNameLength returns the maximum display width of runner group names.
+//
+// Deprecated: use RunnerGroupsTable with a Formatter from LookupFormatter,
+// which computes column widths itself.
 func GetMaxRunnerGroupNameLength(groups []RunnerGroup) int {
 	maxLen := len("Name") // Header length as minimum
 	for _, group := range groups {
-		if len(group.Name) > maxLen {
-			maxLen = len(group.Name)
+		if width := text.DisplayWidth(group.Name); width > maxLen {
+			maxLen = width
 		}
 	}
 	return maxLen
 }
 
-// FormatRunnerGroupWithStatus formats a runner group with visibility info
+// FormatRunnerGroupWithStatus formats a runner group with visibility info.
+//
+// Deprecated: it always emits ANSI color codes. Render RunnerGroupsTable with
+// a Formatter from LookupFormatter, which honors FormatOptions, instead.
 func FormatRunnerGroupWithStatus(group RunnerGroup, nameWidth int) string {
-	var status string
-
-	if group.Default {
-		// Default group (green)
-		status = fmt.Sprintf("%s● %s (default)%s", ColorGreen, group.Visibility, ColorReset)
-	} else if group.Visibility == "private" {
-		// Private (gray)
-		status = fmt.Sprintf("%s● %s%s", ColorGray, group.Visibility, ColorReset)
-	} else {
-		// Selected/All (orange)
-		status = fmt.Sprintf("%s● %s%s", ColorOrange, group.Visibility, ColorReset)
-	}
-
 	// Pad group name to align columns
-	paddedName := group.Name + strings.Repeat(" ", nameWidth-len(group.Name))
-	return fmt.Sprintf("%d\t%s  %s", group.ID, paddedName, status)
+	paddedName := text.PadRight(nameWidth, group.Name)
+	return fmt.Sprintf("%d\t%s  %s", group.ID, paddedName, visibilityCell(group).colored())
 }
 
-// PrintRunnerGroupHeaderAligned prints the runner groups table header with aligned columns
+// PrintRunnerGroupHeaderAligned prints the runner groups table header with aligned columns.
+//
+// Deprecated: use RunnerGroupsTable with a Formatter from LookupFormatter,
+// which computes column widths itself.
 func PrintRunnerGroupHeaderAligned(nameWidth int) {
-	paddedHeader := "Name" + strings.Repeat(" ", nameWidth-len("Name"))
-	fmt.Printf("ID\t%s  Visibility\n", paddedHeader)
+	fmt.Printf("ID\t%s  Visibility\n", text.PadRight(nameWidth, "Name"))
 }
 
 // GetRunnerStatus returns the status string for a runner ("active", "idle", or "offline")
@@ -250,4 +220,96 @@ func FilterRunnersByName(runners []Runner, nameRegex *regexp.Regexp) []Runner {
 		}
 	}
 	return filteredRunners
-}
\ No newline at end of file
+}
+
+// HasLabel reports whether the runner has the named label (case-insensitive, as when routing jobs)
+func HasLabel(runner Runner, name string) bool {
+	for _, label := range runner.Labels {
+		if strings.EqualFold(label.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterRunnersByLabels filters runners that have all of the specified labels
+func FilterRunnersByLabels(runners []Runner, labels []string) []Runner {
+	if len(labels) == 0 {
+		return runners
+	}
+
+	var filteredRunners []Runner
+	for _, runner := range runners {
+		matched := true
+		for _, label := range labels {
+			if !HasLabel(runner, label) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filteredRunners = append(filteredRunners, runner)
+		}
+	}
+	return filteredRunners
+}
+
+// FilterRunnersByAnyLabel filters runners that have at least one of the specified labels
+func FilterRunnersByAnyLabel(runners []Runner, labels []string) []Runner {
+	if len(labels) == 0 {
+		return runners
+	}
+
+	var filteredRunners []Runner
+	for _, runner := range runners {
+		for _, label := range labels {
+			if HasLabel(runner, label) {
+				filteredRunners = append(filteredRunners, runner)
+				break
+			}
+		}
+	}
+	return filteredRunners
+}
+
+// FilterRunnersExcludingLabels filters out runners that have any of the specified labels
+func FilterRunnersExcludingLabels(runners []Runner, labels []string) []Runner {
+	if len(labels) == 0 {
+		return runners
+	}
+
+	var filteredRunners []Runner
+	for _, runner := range runners {
+		excluded := false
+		for _, label := range labels {
+			if HasLabel(runner, label) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			filteredRunners = append(filteredRunners, runner)
+		}
+	}
+	return filteredRunners
+}
+
+// LabelNames returns the names of the runner's labels in API order
+func LabelNames(runner Runner) []string {
+	names := make([]string, 0, len(runner.Labels))
+	for _, label := range runner.Labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+// CustomLabels returns the labels that were added by users
+func CustomLabels(runner Runner) []Label {
+	var labels []Label
+	for _, label := range runner.Labels {
+		if !label.ReadOnly() {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
@@ -1,6 +1,7 @@
 package runnergroup
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 	"testing"
@@ -221,6 +222,13 @@ func TestGetMaxRunnerGroupNameLength(t *testing.T) {
 			},
 			expected: len("Name"), // Header length as minimum
 		},
+		{
+			name: "multibyte names measured by display width",
+			groups: []RunnerGroup{
+				{Name: "本番グループ"},
+			},
+			expected: 12,
+		},
 	}
 
 	for _, tt := range tests {
@@ -584,4 +592,113 @@ func TestFilterRunnersByName(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestRunner_UnmarshalFullModel(t *testing.T) {
+	data := `{
+		"id": 23,
+		"name": "linux-arm-01",
+		"os": "Linux",
+		"status": "online",
+		"busy": false,
+		"ephemeral": true,
+		"runner_group_id": 4,
+		"labels": [
+			{"id": 5, "name": "self-hosted", "type": "read-only"},
+			{"id": 7, "name": "ARM64", "type": "read-only"},
+			{"id": 11, "name": "gpu", "type": "custom"}
+		]
+	}`
+
+	var runner Runner
+	if err := json.Unmarshal([]byte(data), &runner); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if runner.OS != "Linux" || !runner.Ephemeral || runner.RunnerGroupID != 4 {
+		t.Errorf("Unexpected runner fields %+v", runner)
+	}
+	if len(runner.Labels) != 3 {
+		t.Fatalf("Expected 3 labels, got %d", len(runner.Labels))
+	}
+	if !runner.Labels[0].ReadOnly() || runner.Labels[2].ReadOnly() {
+		t.Errorf("Unexpected label types %+v", runner.Labels)
+	}
+
+	if names := strings.Join(LabelNames(runner), ","); names != "self-hosted,ARM64,gpu" {
+		t.Errorf("Expected label names self-hosted,ARM64,gpu, got %q", names)
+	}
+
+	custom := CustomLabels(runner)
+	if len(custom) != 1 || custom[0].Name != "gpu" {
+		t.Errorf("Expected only the gpu custom label, got %+v", custom)
+	}
+}
+
+func TestFilterRunnersByLabels(t *testing.T) {
+	labels := func(names ...string) []Label {
+		result := make([]Label, 0, len(names))
+		for _, name := range names {
+			result = append(result, Label{Name: name, Type: LabelTypeReadOnly})
+		}
+		return result
+	}
+
+	runners := []Runner{
+		{Name: "linux-x64", Labels: labels("self-hosted", "Linux", "X64")},
+		{Name: "linux-arm64", Labels: labels("self-hosted", "Linux", "ARM64")},
+		{Name: "linux-arm64-gpu", Labels: labels("self-hosted", "Linux", "ARM64", "gpu")},
+		{Name: "macos-arm64", Labels: labels("self-hosted", "macOS", "ARM64")},
+	}
+
+	names := func(runners []Runner) []string {
+		result := []string{}
+		for _, runner := range runners {
+			result = append(result, runner.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name          string
+		filter        func([]Runner) []Runner
+		expectedNames []string
+	}{
+		{
+			name:          "all labels (case-insensitive)",
+			filter:        func(r []Runner) []Runner { return FilterRunnersByLabels(r, []string{"linux", "arm64"}) },
+			expectedNames: []string{"linux-arm64", "linux-arm64-gpu"},
+		},
+		{
+			name:          "no labels keeps all runners",
+			filter:        func(r []Runner) []Runner { return FilterRunnersByLabels(r, nil) },
+			expectedNames: []string{"linux-x64", "linux-arm64", "linux-arm64-gpu", "macos-arm64"},
+		},
+		{
+			name:          "any label",
+			filter:        func(r []Runner) []Runner { return FilterRunnersByAnyLabel(r, []string{"x64", "macos"}) },
+			expectedNames: []string{"linux-x64", "macos-arm64"},
+		},
+		{
+			name:          "exclude label",
+			filter:        func(r []Runner) []Runner { return FilterRunnersExcludingLabels(r, []string{"gpu"}) },
+			expectedNames: []string{"linux-x64", "linux-arm64", "macos-arm64"},
+		},
+		{
+			name: "combined all and exclude",
+			filter: func(r []Runner) []Runner {
+				return FilterRunnersExcludingLabels(FilterRunnersByLabels(r, []string{"self-hosted", "linux", "arm64"}), []string{"gpu"})
+			},
+			expectedNames: []string{"linux-arm64"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := names(tt.filter(runners))
+			if strings.Join(result, ",") != strings.Join(tt.expectedNames, ",") {
+				t.Errorf("Expected runners %v, got %v", tt.expectedNames, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package runnergroup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopeKind identifies the level at which runners and runner groups are managed
+type ScopeKind int
+
+const (
+	// ScopeEnterprise targets an enterprise (/enterprises/{enterprise})
+	ScopeEnterprise ScopeKind = iota + 1
+	// ScopeOrganization targets an organization (/orgs/{org})
+	ScopeOrganization
+	// ScopeRepository targets a repository (/repos/{owner}/{repo})
+	ScopeRepository
+)
+
+// Scope identifies the enterprise, organization or repository an API call applies to.
+// For repositories Name is in "owner/repo" form.
+type Scope struct {
+	Kind ScopeKind
+	Name string
+}
+
+// EnterpriseScope returns a scope for the given enterprise
+func EnterpriseScope(enterprise string) Scope {
+	return Scope{Kind: ScopeEnterprise, Name: enterprise}
+}
+
+// OrganizationScope returns a scope for the given organization
+func OrganizationScope(org string) Scope {
+	return Scope{Kind: ScopeOrganization, Name: org}
+}
+
+// RepositoryScope returns a scope for the given "owner/repo" repository
+func RepositoryScope(repo string) Scope {
+	return Scope{Kind: ScopeRepository, Name: repo}
+}
+
+// ParseRepositoryScope returns a repository scope, validating the "owner/repo" form
+func ParseRepositoryScope(repo string) (Scope, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return Scope{}, fmt.Errorf("invalid repository: %q (expected owner/repo)", repo)
+	}
+	return RepositoryScope(repo), nil
+}
+
+// SupportsRunnerGroups reports whether runner groups exist at this scope
+func (s Scope) SupportsRunnerGroups() bool {
+	return s.Kind == ScopeEnterprise || s.Kind == ScopeOrganization
+}
+
+// Path returns the API path prefix for the scope
+func (s Scope) Path() string {
+	switch s.Kind {
+	case ScopeEnterprise:
+		return fmt.Sprintf("/enterprises/%s", s.Name)
+	case ScopeOrganization:
+		return fmt.Sprintf("/orgs/%s", s.Name)
+	case ScopeRepository:
+		return fmt.Sprintf("/repos/%s", s.Name)
+	}
+	return ""
+}
+
+// String returns a human-readable description such as "organization myorg"
+func (s Scope) String() string {
+	switch s.Kind {
+	case ScopeEnterprise:
+		return fmt.Sprintf("enterprise %s", s.Name)
+	case ScopeOrganization:
+		return fmt.Sprintf("organization %s", s.Name)
+	case ScopeRepository:
+		return fmt.Sprintf("repository %s", s.Name)
+	}
+	return "unknown scope"
+}
+
+// validate reports an error for a scope that cannot be used to build API paths
+func (s Scope) validate() error {
+	if s.Path() == "" {
+		return fmt.Errorf("invalid scope: %d", s.Kind)
+	}
+	return nil
+}
+
+// validateGroups reports an error for a scope without runner groups
+func (s Scope) validateGroups() error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	if !s.SupportsRunnerGroups() {
+		return fmt.Errorf("runner groups are not available for %s", s)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package runnergroup
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScope_PathAndString(t *testing.T) {
+	tests := []struct {
+		name           string
+		scope          Scope
+		expectedPath   string
+		expectedString string
+	}{
+		{
+			name:           "enterprise",
+			scope:          EnterpriseScope("myenterprise"),
+			expectedPath:   "/enterprises/myenterprise",
+			expectedString: "enterprise myenterprise",
+		},
+		{
+			name:           "organization",
+			scope:          OrganizationScope("myorg"),
+			expectedPath:   "/orgs/myorg",
+			expectedString: "organization myorg",
+		},
+		{
+			name:           "repository",
+			scope:          RepositoryScope("myorg/myrepo"),
+			expectedPath:   "/repos/myorg/myrepo",
+			expectedString: "repository myorg/myrepo",
+		},
+		{
+			name:           "zero value",
+			scope:          Scope{},
+			expectedPath:   "",
+			expectedString: "unknown scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if path := tt.scope.Path(); path != tt.expectedPath {
+				t.Errorf("Expected path %q, got %q", tt.expectedPath, path)
+			}
+			if str := tt.scope.String(); str != tt.expectedString {
+				t.Errorf("Expected string %q, got %q", tt.expectedString, str)
+			}
+		})
+	}
+}
+
+func TestClient_ScopedMethods(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/enterprises/myenterprise/actions/runner-groups?per_page=100&page=1":           jsonResponse(`{"total_count":1,"runner_groups":[{"id":2,"name":"gpu"}]}`),
+		"/orgs/myorg/actions/runner-groups/2/runners?per_page=100&page=1":               jsonResponse(`{"total_count":1,"runners":[{"id":1,"name":"org-runner"}]}`),
+		"/enterprises/myenterprise/actions/runner-groups/2/runners?per_page=100&page=1": jsonResponse(`{"total_count":1,"runners":[{"id":3,"name":"ent-runner"}]}`),
+	}}
+	client := NewClient().WithTransport(fake)
+	ctx := context.Background()
+
+	groups, err := client.ListGroups(ctx, EnterpriseScope("myenterprise"))
+	if err != nil || len(groups) != 1 || groups[0].Name != "gpu" {
+		t.Errorf("Expected gpu group, got %v (err: %v)", groups, err)
+	}
+
+	runners, err := client.ListGroupRunners(ctx, OrganizationScope("myorg"), 2)
+	if err != nil || len(runners) != 1 || runners[0].Name != "org-runner" {
+		t.Errorf("Expected org-runner, got %v (err: %v)", runners, err)
+	}
+
+	runners, err = client.ListGroupRunners(ctx, EnterpriseScope("myenterprise"), 2)
+	if err != nil || len(runners) != 1 || runners[0].Name != "ent-runner" {
+		t.Errorf("Expected ent-runner, got %v (err: %v)", runners, err)
+	}
+
+	if _, err := client.ListGroups(ctx, Scope{}); err == nil {
+		t.Error("Expected error for invalid scope, got nil")
+	}
+}
+
+func TestParseRepositoryScope(t *testing.T) {
+	tests := []struct {
+		repo        string
+		expectError bool
+	}{
+		{repo: "myorg/myrepo", expectError: false},
+		{repo: "myrepo", expectError: true},
+		{repo: "/myrepo", expectError: true},
+		{repo: "myorg/", expectError: true},
+		{repo: "myorg/myrepo/extra", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			scope, err := ParseRepositoryScope(tt.repo)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %q, got nil", tt.repo)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if scope.Kind != ScopeRepository || scope.Name != tt.repo {
+				t.Errorf("Unexpected scope %+v", scope)
+			}
+		})
+	}
+}
+
+func TestClient_RepositoryRunners(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/repos/myorg/myrepo/actions/runners?per_page=100&page=1": jsonResponse(`{"total_count":2,"runners":[{"id":1,"name":"repo-runner-1","status":"online","busy":true},{"id":2,"name":"repo-runner-2","status":"offline"}]}`),
+	}}
+	client := NewClient().WithTransport(fake)
+	ctx := context.Background()
+	scope := RepositoryScope("myorg/myrepo")
+
+	runners, err := client.ListRunners(ctx, scope)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(runners) != 2 {
+		t.Errorf("Expected 2 runners, got %d", len(runners))
+	}
+
+	if scope.SupportsRunnerGroups() {
+		t.Error("Expected repository scope not to support runner groups")
+	}
+	if _, err := client.ListGroups(ctx, scope); err == nil {
+		t.Error("Expected error listing runner groups for a repository, got nil")
+	}
+	if _, err := client.ListGroupRunners(ctx, scope, 1); err == nil {
+		t.Error("Expected error listing group runners for a repository, got nil")
+	}
+}
@@ -0,0 +1,37 @@
+package runnergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RunnerToken is a short-lived token for registering or removing a self-hosted runner
+type RunnerToken struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// CreateRegistrationToken creates a token for registering a runner at a scope
+func (c *Client) CreateRegistrationToken(ctx context.Context, scope Scope) (*RunnerToken, error) {
+	return c.createRunnerToken(ctx, scope, "registration-token")
+}
+
+// CreateRemoveToken creates a token for removing a runner from a scope
+func (c *Client) CreateRemoveToken(ctx context.Context, scope Scope) (*RunnerToken, error) {
+	return c.createRunnerToken(ctx, scope, "remove-token")
+}
+
+// createRunnerToken requests a runner token of the given kind
+func (c *Client) createRunnerToken(ctx context.Context, scope Scope, kind string) (*RunnerToken, error) {
+	if err := scope.validate(); err != nil {
+		return nil, err
+	}
+
+	var token RunnerToken
+	endpoint := fmt.Sprintf("%s/actions/runners/%s", scope.Path(), kind)
+	if err := c.SendJSON(ctx, http.MethodPost, endpoint, nil, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
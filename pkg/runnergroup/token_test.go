@@ -0,0 +1,35 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCreateRunnerTokens(t *testing.T) {
+	created := jsonResponse(`{"token":"AABF3JGZDX3P5PMEXLND6TS6FCWO6","expires_at":"2026-01-22T12:13:35.123-08:00"}`)
+	created.StatusCode = http.StatusCreated
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/repos/test-org/app/actions/runners/registration-token":    created,
+		"/enterprises/test-enterprise/actions/runners/remove-token": created,
+	}}
+	client := NewClient().WithTransport(fake)
+
+	token, err := client.CreateRegistrationToken(context.Background(), RepositoryScope("test-org/app"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if token.Token != "AABF3JGZDX3P5PMEXLND6TS6FCWO6" || token.ExpiresAt != "2026-01-22T12:13:35.123-08:00" {
+		t.Errorf("Unexpected token %+v", token)
+	}
+
+	if _, err := client.CreateRemoveToken(context.Background(), EnterpriseScope("test-enterprise")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, sent := range fake.requests {
+		if sent.Method != http.MethodPost {
+			t.Errorf("Expected POST to %s, got %s", sent.Path, sent.Method)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package runnergroup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/cli/go-gh/v2"
+)
+
+// Request represents a single GitHub REST API request
+type Request struct {
+	Method   string
+	Path     string
+	Headers  map[string]string
+	Hostname string
+	Body     []byte
+}
+
+// Response represents the raw result of a GitHub REST API request
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Transport sends API requests on behalf of a Client
+type Transport interface {
+	Do(ctx context.Context, req *Request) (*Response, error)
+}
+
+// ExecTransport sends requests by running `gh api` as a subprocess
+type ExecTransport struct{}
+
+// Do executes the request with `gh api` and returns its output
+func (t *ExecTransport) Do(ctx context.Context, req *Request) (*Response, error) {
+	// Include the status line and headers so callers can inspect them
+	args := []string{"api", "--include"}
+
+	if req.Method != "" && req.Method != http.MethodGet {
+		args = append(args, "-X", req.Method)
+	}
+
+	// Add headers
+	for key, value := range req.Headers {
+		args = append(args, "-H", fmt.Sprintf("%s: %s", key, value))
+	}
+
+	// Add hostname if specified
+	if req.Hostname != "" {
+		args = append(args, "--hostname", req.Hostname)
+	}
+
+	// Read the request body from stdin if present
+	if req.Body != nil {
+		args = append(args, "--input", "-")
+	}
+
+	// Add endpoint
+	args = append(args, req.Path)
+
+	ghPath, err := gh.Path()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gh executable: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, ghPath, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if req.Body != nil {
+		cmd.Stdin = bytes.NewReader(req.Body)
+	}
+
+	runErr := cmd.Run()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// gh prints the HTTP response even when the request was unsuccessful
+	resp, err := parseIncludeOutput(stdout.Bytes())
+	if err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("failed to execute gh command: %v\nStderr: %s", runErr, stderr.String())
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// parseIncludeOutput parses the output of `gh api --include` into a Response
+func parseIncludeOutput(output []byte) (*Response, error) {
+	reader := textproto.NewReader(bufio.NewReader(bytes.NewReader(output)))
+
+	statusLine, err := reader.ReadLine()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response status: %v", err)
+	}
+
+	// The status line looks like "HTTP/2.0 200 OK"
+	fields := strings.Fields(statusLine)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "HTTP/") {
+		return nil, fmt.Errorf("malformed response status: %q", statusLine)
+	}
+	statusCode, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed response status: %q", statusLine)
+	}
+
+	header, err := reader.ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read response headers: %v", err)
+	}
+
+	body, err := io.ReadAll(reader.R)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return &Response{
+		StatusCode: statusCode,
+		Header:     http.Header(header),
+		Body:       body,
+	}, nil
+}
+
+// HTTPTransport sends requests in-process using an http.Client
+type HTTPTransport struct {
+	// BaseURL overrides the API root derived from the request hostname
+	BaseURL string
+	// HTTPClient is used to send requests; http.DefaultClient when nil
+	HTTPClient *http.Client
+	// Token is sent as a bearer token when set
+	Token string
+}
+
+// Do sends the request over HTTP and returns the response
+func (t *HTTPTransport) Do(ctx context.Context, req *Request) (*Response, error) {
+	baseURL := t.BaseURL
+	if baseURL == "" {
+		baseURL = apiBaseURL(req.Hostname)
+	}
+
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+
+	url := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(req.Path, "/")
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	for key, value := range req.Headers {
+		httpReq.Header.Set(key, value)
+	}
+	if req.Body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if t.Token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+t.Token)
+	}
+
+	client := t.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return &Response{
+		StatusCode: httpResp.StatusCode,
+		Header:     httpResp.Header,
+		Body:       data,
+	}, nil
+}
+
+// apiBaseURL returns the REST API root for the given hostname
+func apiBaseURL(hostname string) string {
+	if hostname == "" || strings.EqualFold(hostname, "github.com") {
+		return "https://api.github.com"
+	}
+	if strings.HasSuffix(strings.ToLower(hostname), ".ghe.com") {
+		return fmt.Sprintf("https://api.%s", hostname)
+	}
+	return fmt.Sprintf("https://%s/api/v3", hostname)
+}
@@ -0,0 +1,146 @@
+package runnergroup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeTransport returns canned responses keyed by request path
+type fakeTransport struct {
+	responses map[string]*Response
+	requests  []*Request
+}
+
+func (f *fakeTransport) Do(ctx context.Context, req *Request) (*Response, error) {
+	f.requests = append(f.requests, req)
+	if resp, ok := f.responses[req.Path]; ok {
+		return resp, nil
+	}
+	return &Response{StatusCode: http.StatusNotFound, Header: http.Header{}, Body: []byte(`{"message":"Not Found"}`)}, nil
+}
+
+func jsonResponse(body string) *Response {
+	return &Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       []byte(body),
+	}
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	fake := &fakeTransport{responses: map[string]*Response{
+		"/orgs/test-org/actions/runner-groups?per_page=100&page=1": jsonResponse(`{"total_count":1,"runner_groups":[{"id":1,"name":"Default","visibility":"all","default":true}]}`),
+	}}
+
+	client := NewClient().WithHostname("github.example.com").WithTransport(fake)
+	groups, err := client.ListOrgRunnerGroups("test-org")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(groups) != 1 || groups[0].Name != "Default" {
+		t.Errorf("Expected a single Default group, got %v", groups)
+	}
+
+	if len(fake.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(fake.requests))
+	}
+
+	req := fake.requests[0]
+	if req.Hostname != "github.example.com" {
+		t.Errorf("Expected hostname to be passed to transport, got %q", req.Hostname)
+	}
+	if req.Headers["X-GitHub-Api-Version"] != "2022-11-28" {
+		t.Errorf("Expected client headers to be passed to transport, got %v", req.Headers)
+	}
+}
+
+func TestClient_DoNonSuccessStatus(t *testing.T) {
+	client := NewClient().WithTransport(&fakeTransport{})
+
+	if _, err := client.CallAPI("/missing"); err == nil {
+		t.Error("Expected error for non-2xx response, got nil")
+	}
+}
+
+func TestHTTPTransport_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enterprises/test-enterprise/actions/runner-groups/1/runners" {
+			t.Errorf("Unexpected request path %q", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Expected bearer token, got %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		_, _ = w.Write([]byte(`{"total_count":1,"runners":[{"id":7,"name":"runner-1","status":"online","busy":false}]}`))
+	}))
+	defer server.Close()
+
+	transport := &HTTPTransport{BaseURL: server.URL, Token: "secret"}
+	resp, err := transport.Do(context.Background(), &Request{Path: "/enterprises/test-enterprise/actions/runner-groups/1/runners?per_page=100&page=1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") != "42" {
+		t.Errorf("Expected response headers to be returned, got %v", resp.Header)
+	}
+
+	client := NewClient().WithTransport(transport)
+	runners, err := client.GetRunners("test-enterprise", "1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(runners) != 1 || runners[0].Name != "runner-1" {
+		t.Errorf("Expected runner-1, got %v", runners)
+	}
+}
+
+func TestAPIBaseURL(t *testing.T) {
+	tests := []struct {
+		hostname string
+		expected string
+	}{
+		{hostname: "", expected: "https://api.github.com"},
+		{hostname: "github.com", expected: "https://api.github.com"},
+		{hostname: "octocorp.ghe.com", expected: "https://api.octocorp.ghe.com"},
+		{hostname: "github.example.com", expected: "https://github.example.com/api/v3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hostname, func(t *testing.T) {
+			if result := apiBaseURL(tt.hostname); result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseIncludeOutput(t *testing.T) {
+	output := "HTTP/2.0 404 Not Found\nContent-Type: application/json; charset=utf-8\nX-Ratelimit-Remaining: 4999\n\n{\"message\":\"Not Found\"}"
+
+	resp, err := parseIncludeOutput([]byte(output))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") != "4999" {
+		t.Errorf("Expected rate limit header, got %v", resp.Header)
+	}
+	if string(resp.Body) != `{"message":"Not Found"}` {
+		t.Errorf("Unexpected body %q", resp.Body)
+	}
+
+	if _, err := parseIncludeOutput([]byte("not a response")); err == nil {
+		t.Error("Expected error for malformed output, got nil")
+	}
+}
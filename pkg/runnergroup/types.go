@@ -2,15 +2,40 @@ package runnergroup
 
 // Runner represents a GitHub Actions runner
 type Runner struct {
-	ID     int    `json:"id"`
-	Name   string `json:"name"`
-	Status string `json:"status"`
-	Busy   bool   `json:"busy"`
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	OS            string  `json:"os"`
+	Status        string  `json:"status"`
+	Busy          bool    `json:"busy"`
+	Ephemeral     bool    `json:"ephemeral"`
+	RunnerGroupID int     `json:"runner_group_id,omitempty"`
+	Labels        []Label `json:"labels"`
+}
+
+// Label types reported by the API
+const (
+	// LabelTypeReadOnly marks default labels assigned by GitHub (e.g. self-hosted, linux, x64)
+	LabelTypeReadOnly = "read-only"
+	// LabelTypeCustom marks labels added by users
+	LabelTypeCustom = "custom"
+)
+
+// Label represents a label assigned to a runner
+type Label struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ReadOnly reports whether the label is a default label that cannot be removed
+func (l Label) ReadOnly() bool {
+	return l.Type == LabelTypeReadOnly
 }
 
 // RunnersResponse represents the API response containing runners
 type RunnersResponse struct {
-	Runners []Runner `json:"runners"`
+	TotalCount int      `json:"total_count"`
+	Runners    []Runner `json:"runners"`
 }
 
 // RunnerGroup represents a GitHub Actions runner group
@@ -33,9 +58,56 @@ type RunnerGroupsResponse struct {
 	RunnerGroups []RunnerGroup `json:"runner_groups"`
 }
 
+// Repository represents a repository that can use a runner group
+type Repository struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
+}
+
+// RepositoriesResponse represents the API response containing repositories
+type RepositoriesResponse struct {
+	TotalCount   int          `json:"total_count"`
+	Repositories []Repository `json:"repositories"`
+}
+
+// Organization represents an organization that can use an enterprise runner group
+type Organization struct {
+	ID    int    `json:"id"`
+	Login string `json:"login"`
+}
+
+// OrganizationsResponse represents the API response containing organizations
+type OrganizationsResponse struct {
+	TotalCount    int            `json:"total_count"`
+	Organizations []Organization `json:"organizations"`
+}
+
+// RunnerCounts holds the number of runners in each status
+type RunnerCounts struct {
+	Total   int `json:"total"`
+	Active  int `json:"active"`
+	Idle    int `json:"idle"`
+	Offline int `json:"offline"`
+}
+
+// RunnerGroupDetails is a runner group with its runner counts and the
+// repositories or organizations it is shared with
+type RunnerGroupDetails struct {
+	RunnerGroup
+	RunnerCounts          RunnerCounts   `json:"runner_counts"`
+	SelectedRepositories  []Repository   `json:"selected_repositories"`
+	SelectedOrganizations []Organization `json:"selected_organizations"`
+}
+
 // Options represents options for GitHub API calls
 type Options struct {
 	Headers   map[string]string
 	Paginate  bool
 	Hostname  string
+	// MaxItems caps the number of items returned by list calls (0 means no limit)
+	MaxItems  int
+	// MaxRetries is how many times rate-limited or transient failures are retried
+	MaxRetries int
 }
\ No newline at end of file